@@ -0,0 +1,307 @@
+package analysis
+
+import (
+	"cmp"
+	"maps"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/golang/geo/r3"
+
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+)
+
+// AimEngagement is a single moment where a player spotted an enemy, until the player damaged the enemy or lost sight of them.
+type AimEngagement struct {
+	Player *common.Player
+	Enemy  *common.Player
+	Weapon common.EquipmentType // Active weapon of the player when the enemy was spotted
+	Round  int                  // Number of rounds played before the engagement, i.e. 0 for the first round
+
+	StartTick int // Ingame tick at which the enemy was spotted
+	EndTick   int // Ingame tick of the first damage, or of the last frame in which the enemy was still spotted
+
+	// Angle in degrees between the player's crosshair and the enemy's head when the enemy was spotted.
+	CrosshairPlacement float64
+	// Highest angular velocity of the player's crosshair in degrees per second until the end of the engagement.
+	PeakAngularVelocity float64
+
+	Damaged      bool          // True if the player damaged the enemy before losing sight of them
+	ReactionTime time.Duration // Time between spotting and damaging the enemy, only set if Damaged is true
+
+	lastTick      int
+	lastDirection r3.Vector
+}
+
+// AimStats contains the aggregated aim engagements of a player with a specific weapon in a specific round.
+type AimStats struct {
+	Player *common.Player
+	Weapon common.EquipmentType
+	Round  int
+
+	Engagements        int
+	DamagedEngagements int // Engagements in which the player damaged the enemy, see AimEngagement.Damaged
+
+	TotalCrosshairPlacement  float64       // Sum of AimEngagement.CrosshairPlacement of all engagements
+	TotalPeakAngularVelocity float64       // Sum of AimEngagement.PeakAngularVelocity of all engagements
+	TotalReactionTime        time.Duration // Sum of AimEngagement.ReactionTime of all damaged engagements
+}
+
+// AverageCrosshairPlacement returns the average angle in degrees between the crosshair and spotted enemies' heads.
+func (s AimStats) AverageCrosshairPlacement() float64 {
+	if s.Engagements == 0 {
+		return 0
+	}
+
+	return s.TotalCrosshairPlacement / float64(s.Engagements)
+}
+
+// AveragePeakAngularVelocity returns the average peak angular velocity (in degrees per second) of the engagements.
+func (s AimStats) AveragePeakAngularVelocity() float64 {
+	if s.Engagements == 0 {
+		return 0
+	}
+
+	return s.TotalPeakAngularVelocity / float64(s.Engagements)
+}
+
+// AverageReactionTime returns the average time between spotting and damaging an enemy.
+func (s AimStats) AverageReactionTime() time.Duration {
+	if s.DamagedEngagements == 0 {
+		return 0
+	}
+
+	return s.TotalReactionTime / time.Duration(s.DamagedEngagements)
+}
+
+type aimPair struct {
+	player *common.Player
+	enemy  *common.Player
+}
+
+type aimStatsKey struct {
+	player *common.Player
+	weapon common.EquipmentType
+	round  int
+}
+
+// aimSample is the state of an alive player at a specific frame.
+type aimSample struct {
+	team      common.Team
+	eyes      r3.Vector
+	direction r3.Vector
+	weapon    common.EquipmentType
+	spotted   []*common.Player
+}
+
+// AimAnalyzer measures crosshair placement, reaction time and flick speed of players when they spot enemies.
+// Engagements are tracked via the spotted state of players (see Participants().SpottedBy()),
+// which is only sampled once per frame, so all values have a precision of one frame.
+type AimAnalyzer struct {
+	parser demoinfocs.Parser
+
+	engagements []*AimEngagement
+	stats       map[aimStatsKey]*AimStats
+	active      map[aimPair]*AimEngagement
+	spotted     map[aimPair]bool
+}
+
+// NewAimAnalyzer creates an AimAnalyzer and registers its event handlers on the parser.
+func NewAimAnalyzer(parser demoinfocs.Parser) *AimAnalyzer {
+	a := &AimAnalyzer{
+		parser:  parser,
+		stats:   make(map[aimStatsKey]*AimStats),
+		active:  make(map[aimPair]*AimEngagement),
+		spotted: make(map[aimPair]bool),
+	}
+
+	parser.RegisterEventHandler(a.onPlayerHurt)
+	parser.RegisterEventHandler(a.onRoundStart)
+	parser.RegisterEventHandler(a.onFrameDone)
+
+	return a
+}
+
+// Engagements returns all finished engagements in the order they ended.
+// Engagements that ended in the same frame are ordered by StartTick and the UserIDs of Player and Enemy.
+func (a *AimAnalyzer) Engagements() []*AimEngagement {
+	return a.engagements
+}
+
+// Stats returns the AimStats of a player with a weapon in a round, or nil if there were no engagements.
+func (a *AimAnalyzer) Stats(player *common.Player, weapon common.EquipmentType, round int) *AimStats {
+	return a.stats[aimStatsKey{player: player, weapon: weapon, round: round}]
+}
+
+// AllStats returns the AimStats of all players, weapons and rounds.
+func (a *AimAnalyzer) AllStats() []*AimStats {
+	all := make([]*AimStats, 0, len(a.stats))
+
+	for _, s := range a.stats {
+		all = append(all, s)
+	}
+
+	return all
+}
+
+func (a *AimAnalyzer) onFrameDone(events.FrameDone) {
+	gs := a.parser.GameState()
+	samples := make(map[*common.Player]aimSample)
+
+	for _, pl := range gs.Participants().Playing() {
+		if !pl.IsAlive() {
+			continue
+		}
+
+		weapon := common.EqUnknown
+		if wep := pl.ActiveWeapon(); wep != nil {
+			weapon = wep.Type
+		}
+
+		samples[pl] = aimSample{
+			team:      pl.Team,
+			eyes:      pl.PositionEyes(),
+			direction: pl.ViewDirection(),
+			weapon:    weapon,
+			spotted:   gs.Participants().SpottedBy(pl),
+		}
+	}
+
+	a.sample(gs.IngameTick(), gs.TotalRoundsPlayed(), samples)
+}
+
+// sample starts engagements for newly spotted enemies, tracks the crosshair movement of active engagements
+// and ends engagements where the enemy is no longer spotted or one of the players died.
+func (a *AimAnalyzer) sample(tick, round int, samples map[*common.Player]aimSample) {
+	spotted := make(map[aimPair]bool)
+
+	for pl, s := range samples {
+		for _, enemy := range s.spotted {
+			if es, alive := samples[enemy]; alive && es.team != s.team {
+				spotted[aimPair{player: pl, enemy: enemy}] = true
+			}
+		}
+	}
+
+	var lost []aimPair
+
+	for pair, e := range a.active {
+		if !spotted[pair] {
+			lost = append(lost, pair)
+
+			continue
+		}
+
+		a.track(e, tick, samples[pair.player].direction)
+	}
+
+	a.endAll(lost)
+
+	for pair := range spotted {
+		if a.spotted[pair] {
+			continue
+		}
+
+		s := samples[pair.player]
+
+		a.active[pair] = &AimEngagement{
+			Player:             pair.player,
+			Enemy:              pair.enemy,
+			Weapon:             s.weapon,
+			Round:              round,
+			StartTick:          tick,
+			EndTick:            tick,
+			CrosshairPlacement: angleBetween(s.direction, samples[pair.enemy].eyes.Sub(s.eyes)),
+			lastTick:           tick,
+			lastDirection:      s.direction,
+		}
+	}
+
+	a.spotted = spotted
+}
+
+// track updates the peak angular velocity of an engagement.
+func (a *AimAnalyzer) track(e *AimEngagement, tick int, direction r3.Vector) {
+	tickRate := a.parser.TickRate()
+
+	if tick > e.lastTick && tickRate > 0 {
+		elapsed := float64(tick-e.lastTick) / tickRate
+		e.PeakAngularVelocity = math.Max(e.PeakAngularVelocity, angleBetween(e.lastDirection, direction)/elapsed)
+	}
+
+	e.EndTick = tick
+	e.lastTick = tick
+	e.lastDirection = direction
+}
+
+// endAll ends the active engagements of the given pairs in a deterministic order, see Engagements().
+func (a *AimAnalyzer) endAll(pairs []aimPair) {
+	slices.SortFunc(pairs, func(x, y aimPair) int {
+		return cmp.Or(
+			cmp.Compare(a.active[x].StartTick, a.active[y].StartTick),
+			cmp.Compare(x.player.UserID, y.player.UserID),
+			cmp.Compare(x.enemy.UserID, y.enemy.UserID),
+		)
+	})
+
+	for _, pair := range pairs {
+		a.end(pair, a.active[pair])
+	}
+}
+
+func (a *AimAnalyzer) end(pair aimPair, e *AimEngagement) {
+	delete(a.active, pair)
+
+	a.engagements = append(a.engagements, e)
+
+	key := aimStatsKey{player: e.Player, weapon: e.Weapon, round: e.Round}
+
+	s, ok := a.stats[key]
+	if !ok {
+		s = &AimStats{Player: e.Player, Weapon: e.Weapon, Round: e.Round}
+		a.stats[key] = s
+	}
+
+	s.Engagements++
+	s.TotalCrosshairPlacement += e.CrosshairPlacement
+	s.TotalPeakAngularVelocity += e.PeakAngularVelocity
+
+	if e.Damaged {
+		s.DamagedEngagements++
+		s.TotalReactionTime += e.ReactionTime
+	}
+}
+
+func (a *AimAnalyzer) onPlayerHurt(e events.PlayerHurt) {
+	pair := aimPair{player: e.Attacker, enemy: e.Player}
+
+	engagement, ok := a.active[pair]
+	if !ok {
+		return
+	}
+
+	tick := a.parser.GameState().IngameTick()
+
+	engagement.Damaged = true
+	engagement.EndTick = tick
+
+	if tickRate := a.parser.TickRate(); tickRate > 0 {
+		engagement.ReactionTime = time.Duration(float64(tick-engagement.StartTick) / tickRate * float64(time.Second))
+	}
+
+	a.end(pair, engagement)
+}
+
+func (a *AimAnalyzer) onRoundStart(events.RoundStart) {
+	a.endAll(slices.Collect(maps.Keys(a.active)))
+
+	a.spotted = make(map[aimPair]bool)
+}
+
+// angleBetween returns the angle between two vectors in degrees.
+func angleBetween(a, b r3.Vector) float64 {
+	return a.Angle(b).Degrees()
+}
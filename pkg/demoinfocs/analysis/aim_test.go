@@ -0,0 +1,167 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	fake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/fake"
+)
+
+func TestAimAnalyzer(t *testing.T) {
+	parser := fake.NewParser()
+	gs := new(fake.GameState)
+	gs.On("IngameTick").Return(16)
+	parser.On("GameState").Return(gs)
+	parser.On("TickRate").Return(64.0)
+
+	a := NewAimAnalyzer(parser)
+
+	shooter := newPlayer(common.TeamTerrorists)
+	enemy := newPlayer(common.TeamCounterTerrorists)
+	lurker := newPlayer(common.TeamCounterTerrorists)
+	mate := newPlayer(common.TeamTerrorists)
+
+	placement := math.Atan(0.1) * 180 / math.Pi
+	onTarget := r3.Vector{X: 1000, Y: 100}.Normalize()
+
+	a.sample(0, 3, map[*common.Player]aimSample{
+		shooter: {team: common.TeamTerrorists, direction: r3.Vector{X: 1}, weapon: common.EqAK47, spotted: []*common.Player{enemy, mate}},
+		enemy:   {team: common.TeamCounterTerrorists, eyes: r3.Vector{X: 1000, Y: 100}},
+		mate:    {team: common.TeamTerrorists, eyes: r3.Vector{X: 10}},
+	})
+	a.sample(8, 3, map[*common.Player]aimSample{
+		shooter: {team: common.TeamTerrorists, direction: onTarget, weapon: common.EqAK47, spotted: []*common.Player{enemy, lurker}},
+		enemy:   {team: common.TeamCounterTerrorists, eyes: r3.Vector{X: 1000, Y: 100}},
+		lurker:  {team: common.TeamCounterTerrorists, eyes: r3.Vector{Y: -100}},
+	})
+
+	a.onPlayerHurt(events.PlayerHurt{Player: enemy, Attacker: shooter})
+
+	// still spotted after the damage, no new engagement
+	a.sample(16, 3, map[*common.Player]aimSample{
+		shooter: {team: common.TeamTerrorists, direction: onTarget, weapon: common.EqAK47, spotted: []*common.Player{enemy}},
+		enemy:   {team: common.TeamCounterTerrorists, eyes: r3.Vector{X: 1000, Y: 100}},
+		lurker:  {team: common.TeamCounterTerrorists, eyes: r3.Vector{Y: -100}},
+	})
+
+	if assert.Len(t, a.Engagements(), 2) {
+		flick := a.Engagements()[0]
+		assert.Equal(t, shooter, flick.Player)
+		assert.Equal(t, enemy, flick.Enemy)
+		assert.Equal(t, common.EqAK47, flick.Weapon)
+		assert.Equal(t, 3, flick.Round)
+		assert.Equal(t, 0, flick.StartTick)
+		assert.Equal(t, 16, flick.EndTick)
+		assert.InDelta(t, placement, flick.CrosshairPlacement, 0.001)
+		assert.InDelta(t, placement*8, flick.PeakAngularVelocity, 0.001)
+		assert.True(t, flick.Damaged)
+		assert.Equal(t, 250*time.Millisecond, flick.ReactionTime)
+
+		lost := a.Engagements()[1]
+		assert.Equal(t, lurker, lost.Enemy)
+		assert.Equal(t, 8, lost.StartTick)
+		assert.Equal(t, 8, lost.EndTick)
+		assert.InDelta(t, 90+placement, lost.CrosshairPlacement, 0.001)
+		assert.False(t, lost.Damaged)
+		assert.Zero(t, lost.ReactionTime)
+	}
+
+	stats := a.Stats(shooter, common.EqAK47, 3)
+	assert.Equal(t, []*AimStats{stats}, a.AllStats())
+	assert.Equal(t, 2, stats.Engagements)
+	assert.Equal(t, 1, stats.DamagedEngagements)
+	assert.InDelta(t, 45+placement, stats.AverageCrosshairPlacement(), 0.001)
+	assert.InDelta(t, placement*4, stats.AveragePeakAngularVelocity(), 0.001)
+	assert.Equal(t, 250*time.Millisecond, stats.AverageReactionTime())
+	assert.Nil(t, a.Stats(shooter, common.EqAK47, 4))
+}
+
+func TestAimAnalyzer_RoundStart(t *testing.T) {
+	parser := fake.NewParser()
+	parser.On("TickRate").Return(64.0)
+
+	a := NewAimAnalyzer(parser)
+
+	pl := newPlayer(common.TeamTerrorists)
+	enemy := newPlayer(common.TeamCounterTerrorists)
+
+	samples := map[*common.Player]aimSample{
+		pl:    {team: common.TeamTerrorists, direction: r3.Vector{X: 1}, spotted: []*common.Player{enemy}},
+		enemy: {team: common.TeamCounterTerrorists, eyes: r3.Vector{X: 100}},
+	}
+
+	a.sample(0, 0, samples)
+	a.onRoundStart(events.RoundStart{})
+	a.sample(8, 1, samples)
+
+	assert.Len(t, a.Engagements(), 1)
+	assert.Len(t, a.active, 1)
+	assert.Equal(t, 1, a.active[aimPair{player: pl, enemy: enemy}].Round)
+}
+
+func TestAimAnalyzer_EngagementOrder(t *testing.T) {
+	parser := fake.NewParser()
+	parser.On("TickRate").Return(64.0)
+
+	players := make([]*common.Player, 6)
+	for i := range players {
+		players[i] = newPlayer(common.TeamTerrorists)
+		players[i].UserID = len(players) - i
+	}
+
+	enemy := newPlayer(common.TeamCounterTerrorists)
+	enemy.UserID = 10
+	late := newPlayer(common.TeamCounterTerrorists)
+	late.UserID = 11
+
+	spotting := func(spotted ...*common.Player) map[*common.Player]aimSample {
+		samples := map[*common.Player]aimSample{
+			enemy: {team: common.TeamCounterTerrorists},
+			late:  {team: common.TeamCounterTerrorists},
+		}
+
+		for _, pl := range players {
+			samples[pl] = aimSample{team: common.TeamTerrorists, spotted: spotted}
+		}
+
+		return samples
+	}
+
+	// repeated because map iteration order is random
+	for range 10 {
+		a := NewAimAnalyzer(parser)
+
+		a.sample(0, 0, spotting(enemy))
+		a.sample(8, 0, spotting(enemy, late))
+		a.sample(16, 0, spotting())
+
+		engagements := a.Engagements()
+		if !assert.Len(t, engagements, 2*len(players)) {
+			return
+		}
+
+		for i, e := range engagements[:len(players)] {
+			assert.Equal(t, enemy, e.Enemy)
+			assert.Equal(t, i+1, e.Player.UserID)
+		}
+
+		for i, e := range engagements[len(players):] {
+			assert.Equal(t, late, e.Enemy)
+			assert.Equal(t, i+1, e.Player.UserID)
+		}
+	}
+}
+
+func TestAimStats_Averages(t *testing.T) {
+	var s AimStats
+
+	assert.Zero(t, s.AverageCrosshairPlacement())
+	assert.Zero(t, s.AveragePeakAngularVelocity())
+	assert.Zero(t, s.AverageReactionTime())
+}
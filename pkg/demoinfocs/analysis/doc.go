@@ -0,0 +1,5 @@
+// Package analysis contains higher level analyzers that aggregate statistics from the events sent out by a demoinfocs.Parser.
+//
+// Analyzers register their event handlers when they are created, so they need to be created before parsing starts.
+// The results can be queried at any point during or after parsing.
+package analysis
@@ -0,0 +1,267 @@
+package analysis
+
+import (
+	"time"
+
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+)
+
+// DefaultPopFlashMaxFlightTime is the default for FlashAnalyzer.PopFlashMaxFlightTime.
+const DefaultPopFlashMaxFlightTime = time.Second
+
+// maxFlashDuration is an upper bound for how long a flashbang can blind a player.
+// Flashbangs are forgotten once this has passed since their detonation.
+const maxFlashDuration = 6 * time.Second
+
+// FlashStats contains the flashbang effectiveness of a single thrower.
+type FlashStats struct {
+	Thrower *common.Player
+
+	Flashes     int // Number of flashbangs that detonated
+	PopFlashes  int // Number of flashbangs with a flight time <= FlashAnalyzer.PopFlashMaxFlightTime
+	LongFlashes int // Number of flashbangs with a flight time > FlashAnalyzer.PopFlashMaxFlightTime
+
+	EnemiesFlashed        int
+	TeammatesFlashed      int // Includes the thrower flashing themselves
+	EnemyBlindDuration    time.Duration
+	TeammateBlindDuration time.Duration
+
+	PopFlashEnemiesFlashed  int
+	LongFlashEnemiesFlashed int
+
+	FlashAssists int // Kills where the thrower was credited with a flash assist (see events.Kill.AssistedFlash)
+
+	// Kills by the thrower's teammates on enemies that were still blinded by one of the thrower's flashbangs.
+	TeammateKillsOnFlashedEnemies int
+}
+
+// AverageEnemyBlindDuration returns the average duration enemies were blinded for.
+func (s FlashStats) AverageEnemyBlindDuration() time.Duration {
+	if s.EnemiesFlashed == 0 {
+		return 0
+	}
+
+	return s.EnemyBlindDuration / time.Duration(s.EnemiesFlashed)
+}
+
+// AverageTeammateBlindDuration returns the average duration teammates were blinded for.
+func (s FlashStats) AverageTeammateBlindDuration() time.Duration {
+	if s.TeammatesFlashed == 0 {
+		return 0
+	}
+
+	return s.TeammateBlindDuration / time.Duration(s.TeammatesFlashed)
+}
+
+// EnemiesFlashedPerFlash returns the average number of enemies blinded per flashbang.
+func (s FlashStats) EnemiesFlashedPerFlash() float64 {
+	if s.Flashes == 0 {
+		return 0
+	}
+
+	return float64(s.EnemiesFlashed) / float64(s.Flashes)
+}
+
+type flashbang struct {
+	flightTime  time.Duration
+	destroyed   bool
+	destroyedAt time.Duration // Time since the start of the demo, see Parser.CurrentTime()
+}
+
+// FlashAnalyzer aggregates FlashStats per thrower.
+type FlashAnalyzer struct {
+	// PopFlashMaxFlightTime is the maximum time between throw and detonation for a flashbang to count as pop-flash.
+	// Defaults to DefaultPopFlashMaxFlightTime.
+	PopFlashMaxFlightTime time.Duration
+
+	parser        demoinfocs.Parser
+	stats         map[*common.Player]*FlashStats
+	flashbangs    map[*common.GrenadeProjectile]*flashbang
+	counted       map[int64]bool                    // UniqueIDs of counted flashbangs, kept after the flashbang was forgotten
+	lastFlashedBy map[*common.Player]*common.Player // victim -> thrower of the last flashbang that blinded them
+}
+
+// NewFlashAnalyzer creates a FlashAnalyzer and registers its event handlers on the parser.
+func NewFlashAnalyzer(parser demoinfocs.Parser) *FlashAnalyzer {
+	a := &FlashAnalyzer{
+		PopFlashMaxFlightTime: DefaultPopFlashMaxFlightTime,
+		parser:                parser,
+		stats:                 make(map[*common.Player]*FlashStats),
+		flashbangs:            make(map[*common.GrenadeProjectile]*flashbang),
+		counted:               make(map[int64]bool),
+		lastFlashedBy:         make(map[*common.Player]*common.Player),
+	}
+
+	parser.RegisterEventHandler(a.onGrenadeProjectileDestroy)
+	parser.RegisterEventHandler(a.onPlayerFlashed)
+	parser.RegisterEventHandler(a.onKill)
+
+	return a
+}
+
+// Stats returns the FlashStats of a thrower, or nil if the player didn't throw any flashbangs or get any flash assists.
+func (a *FlashAnalyzer) Stats(thrower *common.Player) *FlashStats {
+	return a.stats[thrower]
+}
+
+// AllStats returns the FlashStats of all throwers.
+func (a *FlashAnalyzer) AllStats() []*FlashStats {
+	all := make([]*FlashStats, 0, len(a.stats))
+
+	for _, s := range a.stats {
+		all = append(all, s)
+	}
+
+	return all
+}
+
+func (a *FlashAnalyzer) statsFor(thrower *common.Player) *FlashStats {
+	s, ok := a.stats[thrower]
+	if !ok {
+		s = &FlashStats{Thrower: thrower}
+		a.stats[thrower] = s
+	}
+
+	return s
+}
+
+// flashbangFor returns the tracked flashbang for a projectile.
+// The flight time is taken from the trajectory known at that point, which ends at (or very close to) the detonation.
+func (a *FlashAnalyzer) flashbangFor(proj *common.GrenadeProjectile) *flashbang {
+	fb, ok := a.flashbangs[proj]
+	if ok {
+		return fb
+	}
+
+	fb = &flashbang{flightTime: flightTime(proj)}
+	a.flashbangs[proj] = fb
+
+	return fb
+}
+
+// count adds a flashbang to the stats of the thrower, unless it was already counted.
+func (a *FlashAnalyzer) count(thrower *common.Player, proj *common.GrenadeProjectile, fb *flashbang) {
+	if a.counted[proj.UniqueID()] {
+		return
+	}
+
+	a.counted[proj.UniqueID()] = true
+
+	s := a.statsFor(thrower)
+	s.Flashes++
+
+	if a.isPopFlash(fb) {
+		s.PopFlashes++
+	} else {
+		s.LongFlashes++
+	}
+}
+
+func (a *FlashAnalyzer) isPopFlash(fb *flashbang) bool {
+	return fb.flightTime <= a.PopFlashMaxFlightTime
+}
+
+// forgetDetonated removes flashbangs whose blind window has passed.
+func (a *FlashAnalyzer) forgetDetonated(now time.Duration) {
+	for proj, fb := range a.flashbangs {
+		if fb.destroyed && now-fb.destroyedAt > maxFlashDuration {
+			delete(a.flashbangs, proj)
+		}
+	}
+}
+
+func flightTime(proj *common.GrenadeProjectile) time.Duration {
+	if len(proj.Trajectory) < 2 {
+		return 0
+	}
+
+	return proj.Trajectory[len(proj.Trajectory)-1].Time - proj.Trajectory[0].Time
+}
+
+func (a *FlashAnalyzer) onGrenadeProjectileDestroy(e events.GrenadeProjectileDestroy) {
+	if e.Projectile == nil || e.Projectile.WeaponInstance == nil || e.Projectile.WeaponInstance.Type != common.EqFlash {
+		return
+	}
+
+	now := a.parser.CurrentTime()
+	a.forgetDetonated(now)
+
+	// keep the flashbang around for PlayerFlashed events that are dispatched after the projectile was destroyed
+	fb := a.flashbangFor(e.Projectile)
+	fb.destroyed = true
+	fb.destroyedAt = now
+
+	if e.Projectile.Thrower == nil {
+		return
+	}
+
+	a.count(e.Projectile.Thrower, e.Projectile, fb)
+}
+
+func (a *FlashAnalyzer) onPlayerFlashed(e events.PlayerFlashed) {
+	if e.Player == nil || e.Attacker == nil {
+		return
+	}
+
+	var fb *flashbang
+
+	if e.Projectile != nil {
+		_, tracked := a.flashbangs[e.Projectile]
+
+		if !tracked && a.counted[e.Projectile.UniqueID()] {
+			// the flashbang was already counted and forgotten, don't track it again
+			fb = &flashbang{flightTime: flightTime(e.Projectile)}
+		} else {
+			fb = a.flashbangFor(e.Projectile)
+			a.count(e.Attacker, e.Projectile, fb)
+		}
+	}
+
+	s := a.statsFor(e.Attacker)
+	duration := e.FlashDuration()
+
+	if e.Player != e.Attacker && e.Player.Team != e.Attacker.Team {
+		s.EnemiesFlashed++
+		s.EnemyBlindDuration += duration
+
+		if fb != nil {
+			if a.isPopFlash(fb) {
+				s.PopFlashEnemiesFlashed++
+			} else {
+				s.LongFlashEnemiesFlashed++
+			}
+		}
+
+		a.lastFlashedBy[e.Player] = e.Attacker
+	} else {
+		s.TeammatesFlashed++
+		s.TeammateBlindDuration += duration
+	}
+}
+
+func (a *FlashAnalyzer) onKill(e events.Kill) {
+	if e.AssistedFlash && e.Assister != nil {
+		a.statsFor(e.Assister).FlashAssists++
+	}
+
+	if e.Victim == nil || e.Killer == nil {
+		return
+	}
+
+	thrower, ok := a.lastFlashedBy[e.Victim]
+	if !ok {
+		return
+	}
+
+	delete(a.lastFlashedBy, e.Victim)
+
+	if !e.Victim.IsBlinded() {
+		return
+	}
+
+	if e.Killer != thrower && e.Killer.Team == thrower.Team {
+		a.statsFor(thrower).TeammateKillsOnFlashedEnemies++
+	}
+}
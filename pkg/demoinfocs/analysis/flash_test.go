@@ -0,0 +1,207 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	fake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/fake"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+type demoInfoProviderMock struct {
+	tickRate   float64
+	ingameTick int
+}
+
+func (p demoInfoProviderMock) IngameTick() int {
+	return p.ingameTick
+}
+
+func (p demoInfoProviderMock) TickRate() float64 {
+	return p.tickRate
+}
+
+func (p demoInfoProviderMock) FindPlayerByHandle(uint64) *common.Player {
+	return nil
+}
+
+func (p demoInfoProviderMock) FindPlayerByPawnHandle(uint64) *common.Player {
+	return nil
+}
+
+func (p demoInfoProviderMock) FindWeaponByEntityID(int) *common.Equipment {
+	return nil
+}
+
+func (p demoInfoProviderMock) FindEntityByHandle(uint64) st.Entity {
+	return nil
+}
+
+func (p demoInfoProviderMock) EstimatedVelocity(*common.Player) r3.Vector {
+	return r3.Vector{}
+}
+
+func newPlayer(team common.Team) *common.Player {
+	pl := common.NewPlayer(demoInfoProviderMock{tickRate: 64})
+	pl.Team = team
+
+	return pl
+}
+
+func blinded(pl *common.Player, duration float32) *common.Player {
+	pl.FlashDuration = duration
+
+	return pl
+}
+
+func flashProjectile(thrower *common.Player, flightTime time.Duration) *common.GrenadeProjectile {
+	proj := common.NewGrenadeProjectile()
+	proj.Thrower = thrower
+	proj.Owner = thrower
+	proj.WeaponInstance = common.NewEquipment(common.EqFlash)
+	proj.Trajectory = []common.TrajectoryEntry{
+		{Time: time.Second},
+		{Time: time.Second + flightTime},
+	}
+
+	return proj
+}
+
+func TestFlashAnalyzer(t *testing.T) {
+	parser := fake.NewParser()
+	analyzer := NewFlashAnalyzer(parser)
+
+	thrower := newPlayer(common.TeamTerrorists)
+	mate := newPlayer(common.TeamTerrorists)
+	enemy1 := newPlayer(common.TeamCounterTerrorists)
+	enemy2 := newPlayer(common.TeamCounterTerrorists)
+
+	pop := flashProjectile(thrower, 500*time.Millisecond)
+	long := flashProjectile(thrower, 2*time.Second)
+
+	parser.MockEvents(
+		events.GrenadeProjectileDestroy{Projectile: pop},
+		events.PlayerFlashed{Player: blinded(enemy1, 3), Attacker: thrower, Projectile: pop},
+		events.PlayerFlashed{Player: blinded(mate, 1), Attacker: thrower, Projectile: pop},
+	)
+	parser.MockEvents(
+		events.GrenadeProjectileDestroy{Projectile: long},
+		events.PlayerFlashed{Player: blinded(enemy2, 1), Attacker: thrower, Projectile: long},
+	)
+	parser.MockEvents(
+		events.Kill{Killer: mate, Victim: enemy1, Assister: thrower, AssistedFlash: true},
+	)
+	parser.On("ParseToEnd").Return(nil)
+	parser.On("CurrentTime").Return(10 * time.Second)
+
+	err := parser.ParseToEnd()
+	assert.NoError(t, err)
+
+	stats := analyzer.Stats(thrower)
+	assert.Equal(t, &FlashStats{
+		Thrower:                       thrower,
+		Flashes:                       2,
+		PopFlashes:                    1,
+		LongFlashes:                   1,
+		EnemiesFlashed:                2,
+		TeammatesFlashed:              1,
+		EnemyBlindDuration:            4 * time.Second,
+		TeammateBlindDuration:         time.Second,
+		PopFlashEnemiesFlashed:        1,
+		LongFlashEnemiesFlashed:       1,
+		FlashAssists:                  1,
+		TeammateKillsOnFlashedEnemies: 1,
+	}, stats)
+	assert.Equal(t, 2*time.Second, stats.AverageEnemyBlindDuration())
+	assert.Equal(t, time.Second, stats.AverageTeammateBlindDuration())
+	assert.Equal(t, 1.0, stats.EnemiesFlashedPerFlash())
+	assert.Len(t, analyzer.AllStats(), 1)
+}
+
+func TestFlashAnalyzer_KillAfterBlindWindow(t *testing.T) {
+	parser := fake.NewParser()
+	analyzer := NewFlashAnalyzer(parser)
+
+	thrower := newPlayer(common.TeamTerrorists)
+	mate := newPlayer(common.TeamTerrorists)
+	enemy := newPlayer(common.TeamCounterTerrorists)
+
+	parser.MockEvents(events.PlayerFlashed{Player: blinded(enemy, 1), Attacker: thrower})
+	parser.MockEvents(events.Kill{Killer: mate, Victim: enemy})
+	parser.On("ParseToEnd").Return(nil)
+
+	// blind window expires before the kill
+	parser.RegisterEventHandler(func(events.PlayerFlashed) {
+		enemy.FlashTick = -128
+	})
+
+	err := parser.ParseToEnd()
+	assert.NoError(t, err)
+
+	stats := analyzer.Stats(thrower)
+	assert.Equal(t, 1, stats.EnemiesFlashed)
+	assert.Zero(t, stats.Flashes)
+	assert.Zero(t, stats.TeammateKillsOnFlashedEnemies)
+}
+
+func TestFlashAnalyzer_ForgetsDetonatedFlashbangs(t *testing.T) {
+	parser := fake.NewParser()
+	analyzer := NewFlashAnalyzer(parser)
+
+	thrower := newPlayer(common.TeamTerrorists)
+	enemy := newPlayer(common.TeamCounterTerrorists)
+
+	first := flashProjectile(thrower, time.Second)
+	second := flashProjectile(thrower, time.Second)
+
+	parser.MockEvents(
+		events.GrenadeProjectileDestroy{Projectile: first},
+		events.PlayerFlashed{Player: blinded(enemy, 1), Attacker: thrower, Projectile: first},
+	)
+	parser.MockEvents(events.GrenadeProjectileDestroy{Projectile: second})
+	parser.On("ParseToEnd").Return(nil)
+	parser.On("CurrentTime").Return(10 * time.Second).Once()
+	parser.On("CurrentTime").Return(10*time.Second + maxFlashDuration + time.Millisecond).Once()
+
+	err := parser.ParseToEnd()
+	assert.NoError(t, err)
+
+	assert.NotContains(t, analyzer.flashbangs, first)
+	assert.Contains(t, analyzer.flashbangs, second)
+	assert.Equal(t, 2, analyzer.Stats(thrower).Flashes)
+}
+
+func TestFlashAnalyzer_LatePlayerFlashed(t *testing.T) {
+	parser := fake.NewParser()
+	analyzer := NewFlashAnalyzer(parser)
+
+	thrower := newPlayer(common.TeamTerrorists)
+	enemy := newPlayer(common.TeamCounterTerrorists)
+
+	first := flashProjectile(thrower, 2*time.Second)
+	second := flashProjectile(thrower, time.Second)
+
+	parser.MockEvents(events.GrenadeProjectileDestroy{Projectile: first})
+	parser.MockEvents(events.GrenadeProjectileDestroy{Projectile: second}) // forgets the first flashbang
+	parser.MockEvents(events.PlayerFlashed{Player: blinded(enemy, 1), Attacker: thrower, Projectile: first})
+	parser.On("ParseToEnd").Return(nil)
+	parser.On("CurrentTime").Return(10 * time.Second).Once()
+	parser.On("CurrentTime").Return(10*time.Second + maxFlashDuration + time.Millisecond).Once()
+
+	err := parser.ParseToEnd()
+	assert.NoError(t, err)
+
+	assert.NotContains(t, analyzer.flashbangs, first)
+
+	stats := analyzer.Stats(thrower)
+	assert.Equal(t, 2, stats.Flashes)
+	assert.Equal(t, 1, stats.PopFlashes)
+	assert.Equal(t, 1, stats.LongFlashes)
+	assert.Equal(t, 1, stats.EnemiesFlashed)
+	assert.Equal(t, 1, stats.LongFlashEnemiesFlashed)
+}
@@ -0,0 +1,227 @@
+package analysis
+
+import (
+	"math"
+	"time"
+
+	"github.com/golang/geo/r3"
+
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+)
+
+// InfernoFireRadius is the approximate distance from a single fire at which a player is considered to be standing in the fire.
+const InfernoFireRadius = 60.0
+
+// InfernoAreaSample is the burning area of an inferno at a specific tick.
+type InfernoAreaSample struct {
+	Tick int
+	Area float64 // Area of the 2D convex hull of all active fires in square world units
+}
+
+// InfernoReport contains the area control and damage caused by a single inferno (Molotov or incendiary).
+type InfernoReport struct {
+	Inferno *common.Inferno
+	Thrower *common.Player // May be nil if the thrower is unknown
+
+	StartTick int
+	EndTick   int           // 0 while the inferno is still burning
+	Duration  time.Duration // Only set once the inferno expired
+
+	AreaSamples []InfernoAreaSample // One sample per frame while the inferno is burning
+	PeakArea    float64
+
+	TotalDamage    int                    // Health damage taken by all players, excluding over-damage
+	DamageByVictim map[*common.Player]int // Health damage taken per player, excluding over-damage
+
+	// Players that stood in the fire and moved out of it alive while it was still burning.
+	Repositioned []*common.Player
+
+	lastFires      common.Fires
+	playersInFire  map[*common.Player]bool
+	isRepositioned map[*common.Player]bool
+}
+
+// InfernoAnalyzer samples the burning area of infernos and attributes fire damage to the inferno that caused it.
+type InfernoAnalyzer struct {
+	parser demoinfocs.Parser
+
+	reports       []*InfernoReport
+	active        map[*common.Inferno]*InfernoReport
+	lastPositions map[*common.Player]r3.Vector
+}
+
+// NewInfernoAnalyzer creates an InfernoAnalyzer and registers its event handlers on the parser.
+func NewInfernoAnalyzer(parser demoinfocs.Parser) *InfernoAnalyzer {
+	a := &InfernoAnalyzer{
+		parser:        parser,
+		active:        make(map[*common.Inferno]*InfernoReport),
+		lastPositions: make(map[*common.Player]r3.Vector),
+	}
+
+	parser.RegisterEventHandler(a.onInfernoStart)
+	parser.RegisterEventHandler(a.onInfernoExpired)
+	parser.RegisterEventHandler(a.onPlayerHurt)
+	parser.RegisterEventHandler(a.onFrameDone)
+
+	return a
+}
+
+// Reports returns the reports of all infernos in the order they started.
+func (a *InfernoAnalyzer) Reports() []*InfernoReport {
+	return a.reports
+}
+
+// Report returns the report of a specific inferno, or nil if the inferno is unknown.
+func (a *InfernoAnalyzer) Report(inferno *common.Inferno) *InfernoReport {
+	for _, r := range a.reports {
+		if r.Inferno == inferno {
+			return r
+		}
+	}
+
+	return nil
+}
+
+func (a *InfernoAnalyzer) onInfernoStart(e events.InfernoStart) {
+	r := &InfernoReport{
+		Inferno:        e.Inferno,
+		Thrower:        e.Inferno.Thrower(),
+		StartTick:      a.parser.GameState().IngameTick(),
+		DamageByVictim: make(map[*common.Player]int),
+		playersInFire:  make(map[*common.Player]bool),
+		isRepositioned: make(map[*common.Player]bool),
+	}
+
+	a.reports = append(a.reports, r)
+	a.active[e.Inferno] = r
+}
+
+func (a *InfernoAnalyzer) onInfernoExpired(e events.InfernoExpired) {
+	r, ok := a.active[e.Inferno]
+	if !ok {
+		return
+	}
+
+	delete(a.active, e.Inferno)
+
+	r.EndTick = a.parser.GameState().IngameTick()
+
+	if tickRate := a.parser.TickRate(); tickRate > 0 {
+		r.Duration = time.Duration(float64(r.EndTick-r.StartTick) / tickRate * float64(time.Second))
+	}
+}
+
+func (a *InfernoAnalyzer) onFrameDone(events.FrameDone) {
+	if len(a.active) == 0 {
+		a.lastPositions = make(map[*common.Player]r3.Vector)
+
+		return
+	}
+
+	gs := a.parser.GameState()
+	positions := make(map[*common.Player]r3.Vector)
+
+	for _, pl := range gs.Participants().Playing() {
+		if pl.IsAlive() {
+			positions[pl] = pl.Position()
+		}
+	}
+
+	for inf, r := range a.active {
+		a.sample(r, gs.IngameTick(), inf.Fires().Active(), positions)
+	}
+
+	a.lastPositions = positions
+}
+
+// sample records the burning area and tracks which of the (alive) players are standing in the fire.
+func (a *InfernoAnalyzer) sample(r *InfernoReport, tick int, fires common.Fires, positions map[*common.Player]r3.Vector) {
+	area := fires.Area2D()
+
+	r.AreaSamples = append(r.AreaSamples, InfernoAreaSample{Tick: tick, Area: area})
+	r.PeakArea = math.Max(r.PeakArea, area)
+	r.lastFires = fires
+
+	for pl, wasInFire := range r.playersInFire {
+		if _, alive := positions[pl]; !alive {
+			delete(r.playersInFire, pl)
+
+			continue
+		}
+
+		if wasInFire && !inFire(fires, positions[pl]) && !r.isRepositioned[pl] {
+			r.isRepositioned[pl] = true
+			r.Repositioned = append(r.Repositioned, pl)
+		}
+	}
+
+	for pl, pos := range positions {
+		r.playersInFire[pl] = inFire(fires, pos)
+	}
+}
+
+func inFire(fires common.Fires, pos r3.Vector) bool {
+	return distanceToFire(fires, pos) <= InfernoFireRadius
+}
+
+func distanceToFire(fires common.Fires, pos r3.Vector) float64 {
+	dist := math.Inf(1)
+
+	for _, f := range fires.List() {
+		dist = math.Min(dist, f.Vector.Distance(pos))
+	}
+
+	return dist
+}
+
+func (a *InfernoAnalyzer) onPlayerHurt(e events.PlayerHurt) {
+	if e.Player == nil || e.Weapon == nil {
+		return
+	}
+
+	if e.Weapon.Type != common.EqMolotov && e.Weapon.Type != common.EqIncendiary {
+		return
+	}
+
+	r := a.infernoCausingDamage(e.Player, e.Attacker)
+	if r == nil {
+		return
+	}
+
+	r.TotalDamage += e.HealthDamageTaken
+	r.DamageByVictim[e.Player] += e.HealthDamageTaken
+}
+
+// infernoCausingDamage returns the report of the burning inferno that most likely hurt the victim.
+// Infernos thrown by the attacker are preferred, ties are resolved by the distance of the victim to the closest fire.
+func (a *InfernoAnalyzer) infernoCausingDamage(victim, attacker *common.Player) *InfernoReport {
+	pos, hasPos := a.lastPositions[victim]
+
+	var (
+		best          *InfernoReport
+		bestDist      = math.Inf(1)
+		bestByThrower bool
+	)
+
+	for _, r := range a.active {
+		byThrower := attacker != nil && r.Thrower == attacker
+		if bestByThrower && !byThrower {
+			continue
+		}
+
+		dist := math.Inf(1)
+		if hasPos {
+			dist = distanceToFire(r.lastFires, pos)
+		}
+
+		if best == nil || byThrower && !bestByThrower || dist < bestDist || dist == bestDist && r.StartTick > best.StartTick {
+			best = r
+			bestDist = dist
+			bestByThrower = byThrower
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,132 @@
+package analysis
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	fake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/fake"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
+)
+
+func infernoWithFires(thrower *common.Player, positions ...r3.Vector) *common.Inferno {
+	entity := new(stfake.Entity)
+	entity.On("Position").Return(r3.Vector{})
+	entity.On("PropertyValueMust", "m_fireCount").Return(st.PropertyValue{Any: int32(len(positions))})
+
+	for i, pos := range positions {
+		iStr := fmt.Sprintf("%04d", i)
+
+		prop := new(stfake.Property)
+		prop.On("Value").Return(st.PropertyValue{Any: []float32{float32(pos.X), float32(pos.Y), float32(pos.Z)}})
+
+		entity.On("PropertyValueMust", "m_bFireIsBurning."+iStr).Return(st.PropertyValue{Any: true})
+		entity.On("Property", "m_firePositions."+iStr).Return(prop)
+	}
+
+	return common.NewInferno(nil, entity, thrower)
+}
+
+func TestInfernoAnalyzer_DurationAndDamage(t *testing.T) {
+	parser := fake.NewParser()
+	gs := new(fake.GameState)
+	gs.On("IngameTick").Return(0).Once()
+	gs.On("IngameTick").Return(448)
+	parser.On("GameState").Return(gs)
+	parser.On("TickRate").Return(64.0)
+	parser.On("ParseToEnd").Return(nil)
+
+	analyzer := NewInfernoAnalyzer(parser)
+
+	thrower := newPlayer(common.TeamTerrorists)
+	victim := newPlayer(common.TeamCounterTerrorists)
+	inf := infernoWithFires(thrower, r3.Vector{})
+	molotov := common.NewEquipment(common.EqMolotov)
+
+	parser.MockEvents(events.InfernoStart{Inferno: inf})
+	parser.MockEvents(
+		events.PlayerHurt{Player: victim, Attacker: thrower, Weapon: molotov, HealthDamageTaken: 8},
+		events.PlayerHurt{Player: victim, Attacker: thrower, Weapon: molotov, HealthDamageTaken: 4},
+		events.PlayerHurt{Player: victim, Attacker: thrower, Weapon: common.NewEquipment(common.EqAK47), HealthDamageTaken: 27},
+	)
+	parser.MockEvents(events.InfernoExpired{Inferno: inf})
+
+	err := parser.ParseToEnd()
+	assert.NoError(t, err)
+
+	r := analyzer.Report(inf)
+	assert.Equal(t, []*InfernoReport{r}, analyzer.Reports())
+	assert.Equal(t, thrower, r.Thrower)
+	assert.Equal(t, 0, r.StartTick)
+	assert.Equal(t, 448, r.EndTick)
+	assert.Equal(t, 7*time.Second, r.Duration)
+	assert.Equal(t, 12, r.TotalDamage)
+	assert.Equal(t, map[*common.Player]int{victim: 12}, r.DamageByVictim)
+}
+
+func TestInfernoAnalyzer_DamageAttribution(t *testing.T) {
+	thrower1 := newPlayer(common.TeamTerrorists)
+	thrower2 := newPlayer(common.TeamTerrorists)
+	victim := newPlayer(common.TeamCounterTerrorists)
+
+	a := &InfernoAnalyzer{
+		active:        make(map[*common.Inferno]*InfernoReport),
+		lastPositions: map[*common.Player]r3.Vector{victim: {X: 1000}},
+	}
+
+	near := &InfernoReport{Thrower: thrower1, lastFires: infernoWithFires(thrower1, r3.Vector{X: 990}).Fires()}
+	far := &InfernoReport{Thrower: thrower1, lastFires: infernoWithFires(thrower1, r3.Vector{}).Fires()}
+	other := &InfernoReport{Thrower: thrower2, lastFires: infernoWithFires(thrower2, r3.Vector{X: 1000}).Fires()}
+	a.active[new(common.Inferno)] = near
+	a.active[new(common.Inferno)] = far
+	a.active[new(common.Inferno)] = other
+
+	assert.Equal(t, near, a.infernoCausingDamage(victim, thrower1))
+	assert.Equal(t, other, a.infernoCausingDamage(victim, thrower2))
+	assert.Equal(t, other, a.infernoCausingDamage(victim, nil))
+}
+
+func TestInfernoAnalyzer_Sample(t *testing.T) {
+	a := new(InfernoAnalyzer)
+	r := &InfernoReport{
+		playersInFire:  make(map[*common.Player]bool),
+		isRepositioned: make(map[*common.Player]bool),
+	}
+	fires := infernoWithFires(nil, r3.Vector{}, r3.Vector{X: 100}, r3.Vector{Y: 100}).Fires()
+
+	mover := newPlayer(common.TeamCounterTerrorists)
+	stayer := newPlayer(common.TeamCounterTerrorists)
+	dier := newPlayer(common.TeamCounterTerrorists)
+	outsider := newPlayer(common.TeamCounterTerrorists)
+
+	a.sample(r, 1, fires, map[*common.Player]r3.Vector{
+		mover:    {X: 10},
+		stayer:   {X: 10},
+		dier:     {X: 10},
+		outsider: {X: 500},
+	})
+	a.sample(r, 2, fires, map[*common.Player]r3.Vector{
+		mover:    {X: 500},
+		stayer:   {X: 20},
+		outsider: {X: 600},
+	})
+	a.sample(r, 3, fires, map[*common.Player]r3.Vector{
+		mover:    {X: 10},
+		stayer:   {X: 20},
+		dier:     {X: 500},
+		outsider: {X: 600},
+	})
+	a.sample(r, 4, fires, map[*common.Player]r3.Vector{
+		mover: {X: 500},
+	})
+
+	assert.Equal(t, []*common.Player{mover}, r.Repositioned)
+	assert.Equal(t, []InfernoAreaSample{{1, 5000}, {2, 5000}, {3, 5000}, {4, 5000}}, r.AreaSamples)
+	assert.Equal(t, 5000.0, r.PeakArea)
+}
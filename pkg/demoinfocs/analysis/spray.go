@@ -0,0 +1,284 @@
+package analysis
+
+import (
+	"math"
+	"time"
+
+	"github.com/golang/geo/r3"
+
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+)
+
+// DefaultSprayMaxShotInterval is the default for SprayAnalyzer.MaxShotInterval.
+const DefaultSprayMaxShotInterval = 250 * time.Millisecond
+
+// RecoilScale is the factor between the aim punch and the offset of the bullet from the crosshair (weapon_recoil_scale).
+const RecoilScale = 2.0
+
+// SprayBullet is a single shot of a spray.
+type SprayBullet struct {
+	Tick        int
+	RecoilIndex float32   // Recoil index of the weapon when the shot was fired, see Equipment.RecoilIndex()
+	ViewAngles  r3.Vector // X = pitch, Y = yaw, in degrees
+	AimPunch    r3.Vector // X = pitch, Y = yaw, in degrees, see Player.AimPunchAngle()
+	Speed       float64   // Horizontal speed of the shooter, see Player.Speed2D()
+	Hit         bool      // True if the shooter damaged a player with the weapon on the same tick
+
+	// ViewDelta is the change of the view angles since the first bullet of the spray (X = pitch, Y = yaw).
+	// With perfect recoil control this is the inverse of RecoilScale * (AimPunch - first bullet's AimPunch).
+	ViewDelta r3.Vector
+	// CompensationError is the angle in degrees between where the bullet went (view angles + RecoilScale * aim punch)
+	// and where the first bullet of the spray went, i.e. the deviation from perfectly compensating the recoil pattern.
+	// Always 0 for the first bullet.
+	CompensationError float64
+}
+
+// Spray is a series of consecutive shots by a player with the same weapon.
+type Spray struct {
+	Shooter *common.Player
+	Weapon  common.EquipmentType
+	Round   int // Number of rounds played before the spray, i.e. 0 for the first round
+	Bullets []SprayBullet
+}
+
+// FirstBulletMoving returns true if the shooter moved faster than the weapon's accurate speed when firing the first bullet.
+func (s *Spray) FirstBulletMoving() bool {
+	return s.Bullets[0].Speed > s.Weapon.AccurateSpeed()
+}
+
+// AverageCompensationError returns the average CompensationError of all bullets after the first one.
+func (s *Spray) AverageCompensationError() float64 {
+	if len(s.Bullets) < 2 {
+		return 0
+	}
+
+	var total float64
+
+	for _, b := range s.Bullets[1:] {
+		total += b.CompensationError
+	}
+
+	return total / float64(len(s.Bullets)-1)
+}
+
+// SprayStats contains the aggregated sprays of a player with a specific weapon.
+type SprayStats struct {
+	Player *common.Player
+	Weapon common.EquipmentType
+
+	Sprays  int
+	Bullets int
+
+	FirstBulletHits    int // First bullets that hit a player
+	FirstBulletsMoving int // First bullets fired while moving faster than EquipmentType.AccurateSpeed()
+
+	TotalCompensationError float64 // Sum of SprayBullet.CompensationError of all bullets after the first of each spray
+}
+
+// FirstBulletAccuracy returns the fraction of sprays where the first bullet hit a player.
+func (s SprayStats) FirstBulletAccuracy() float64 {
+	if s.Sprays == 0 {
+		return 0
+	}
+
+	return float64(s.FirstBulletHits) / float64(s.Sprays)
+}
+
+// FirstBulletsMovingRatio returns the fraction of sprays where the first bullet was fired while moving too fast.
+func (s SprayStats) FirstBulletsMovingRatio() float64 {
+	if s.Sprays == 0 {
+		return 0
+	}
+
+	return float64(s.FirstBulletsMoving) / float64(s.Sprays)
+}
+
+// AverageCompensationError returns the average SprayBullet.CompensationError of all bullets after the first of each spray.
+func (s SprayStats) AverageCompensationError() float64 {
+	if s.Bullets <= s.Sprays {
+		return 0
+	}
+
+	return s.TotalCompensationError / float64(s.Bullets-s.Sprays)
+}
+
+type sprayStatsKey struct {
+	player *common.Player
+	weapon common.EquipmentType
+}
+
+// SprayAnalyzer groups consecutive shots into sprays and measures recoil control and first-bullet accuracy.
+type SprayAnalyzer struct {
+	// MaxShotInterval is the maximum time between two shots for them to belong to the same spray.
+	// Defaults to DefaultSprayMaxShotInterval.
+	MaxShotInterval time.Duration
+
+	parser demoinfocs.Parser
+
+	sprays []*Spray
+	stats  map[sprayStatsKey]*SprayStats
+	active map[*common.Player]*Spray
+}
+
+// NewSprayAnalyzer creates a SprayAnalyzer and registers its event handlers on the parser.
+func NewSprayAnalyzer(parser demoinfocs.Parser) *SprayAnalyzer {
+	a := &SprayAnalyzer{
+		MaxShotInterval: DefaultSprayMaxShotInterval,
+		parser:          parser,
+		stats:           make(map[sprayStatsKey]*SprayStats),
+		active:          make(map[*common.Player]*Spray),
+	}
+
+	parser.RegisterEventHandler(a.onWeaponFire)
+	parser.RegisterEventHandler(a.onPlayerHurt)
+	parser.RegisterEventHandler(a.onRoundStart)
+
+	return a
+}
+
+// Sprays returns all sprays in the order they started, including sprays that may still be continued.
+func (a *SprayAnalyzer) Sprays() []*Spray {
+	return a.sprays
+}
+
+// Stats returns the SprayStats of a player with a weapon, or nil if the player didn't shoot the weapon.
+func (a *SprayAnalyzer) Stats(player *common.Player, weapon common.EquipmentType) *SprayStats {
+	return a.stats[sprayStatsKey{player: player, weapon: weapon}]
+}
+
+// AllStats returns the SprayStats of all players and weapons.
+func (a *SprayAnalyzer) AllStats() []*SprayStats {
+	all := make([]*SprayStats, 0, len(a.stats))
+
+	for _, s := range a.stats {
+		all = append(all, s)
+	}
+
+	return all
+}
+
+func (a *SprayAnalyzer) onWeaponFire(e events.WeaponFire) {
+	if e.Shooter == nil || e.Weapon == nil || !e.Weapon.IsFirearm() {
+		return
+	}
+
+	gs := a.parser.GameState()
+
+	a.addBullet(e.Shooter, e.Weapon.Type, gs.TotalRoundsPlayed(), SprayBullet{
+		Tick:        gs.IngameTick(),
+		RecoilIndex: e.Weapon.RecoilIndex(),
+		ViewAngles:  viewAngles(e.Shooter),
+		AimPunch:    e.Shooter.AimPunchAngle(),
+		Speed:       e.Shooter.Speed2D(),
+	})
+}
+
+// addBullet adds a bullet to the active spray of the shooter or starts a new spray
+// if the weapon changed or too much time passed since the previous shot.
+func (a *SprayAnalyzer) addBullet(shooter *common.Player, weapon common.EquipmentType, round int, b SprayBullet) {
+	key := sprayStatsKey{player: shooter, weapon: weapon}
+
+	stats, ok := a.stats[key]
+	if !ok {
+		stats = &SprayStats{Player: shooter, Weapon: weapon}
+		a.stats[key] = stats
+	}
+
+	spray, ok := a.active[shooter]
+	if ok && (spray.Weapon != weapon || spray.Round != round || a.elapsed(spray.Bullets[len(spray.Bullets)-1].Tick, b.Tick) > a.MaxShotInterval) {
+		ok = false
+	}
+
+	if !ok {
+		spray = &Spray{Shooter: shooter, Weapon: weapon, Round: round}
+		a.sprays = append(a.sprays, spray)
+		a.active[shooter] = spray
+
+		stats.Sprays++
+
+		if b.Speed > weapon.AccurateSpeed() {
+			stats.FirstBulletsMoving++
+		}
+	} else {
+		first := spray.Bullets[0]
+		b.ViewDelta = r3.Vector{
+			X: b.ViewAngles.X - first.ViewAngles.X,
+			Y: normalizeYaw(b.ViewAngles.Y - first.ViewAngles.Y),
+		}
+		b.CompensationError = angleBetween(bulletDirection(first), bulletDirection(b))
+		stats.TotalCompensationError += b.CompensationError
+	}
+
+	stats.Bullets++
+	spray.Bullets = append(spray.Bullets, b)
+}
+
+func (a *SprayAnalyzer) elapsed(fromTick, toTick int) time.Duration {
+	tickRate := a.parser.TickRate()
+	if tickRate <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(toTick-fromTick) / tickRate * float64(time.Second))
+}
+
+func (a *SprayAnalyzer) onPlayerHurt(e events.PlayerHurt) {
+	if e.Attacker == nil || e.Weapon == nil {
+		return
+	}
+
+	a.hit(e.Attacker, e.Weapon.Type, a.parser.GameState().IngameTick())
+}
+
+// hit marks the shooter's last bullet as hit if it was fired with the weapon on the given tick.
+func (a *SprayAnalyzer) hit(shooter *common.Player, weapon common.EquipmentType, tick int) {
+	spray, ok := a.active[shooter]
+	if !ok || spray.Weapon != weapon {
+		return
+	}
+
+	last := &spray.Bullets[len(spray.Bullets)-1]
+	if last.Tick != tick || last.Hit {
+		return
+	}
+
+	last.Hit = true
+
+	if len(spray.Bullets) == 1 {
+		a.stats[sprayStatsKey{player: shooter, weapon: weapon}].FirstBulletHits++
+	}
+}
+
+func (a *SprayAnalyzer) onRoundStart(events.RoundStart) {
+	a.active = make(map[*common.Player]*Spray)
+}
+
+// viewAngles returns the player's view angles with the pitch normalized to -90 to 90.
+func viewAngles(pl *common.Player) r3.Vector {
+	pitch := float64(pl.ViewDirectionY())
+	if pitch > 180 {
+		pitch -= 360
+	}
+
+	return r3.Vector{X: pitch, Y: float64(pl.ViewDirectionX())}
+}
+
+// bulletDirection returns the unit vector in which a bullet travelled, ignoring spread.
+func bulletDirection(b SprayBullet) r3.Vector {
+	return common.AnglesToDirection(b.ViewAngles.X+RecoilScale*b.AimPunch.X, b.ViewAngles.Y+RecoilScale*b.AimPunch.Y)
+}
+
+func normalizeYaw(yaw float64) float64 {
+	yaw = math.Mod(yaw, 360)
+
+	switch {
+	case yaw > 180:
+		yaw -= 360
+	case yaw <= -180:
+		yaw += 360
+	}
+
+	return yaw
+}
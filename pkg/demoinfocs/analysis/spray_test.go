@@ -0,0 +1,96 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	fake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/fake"
+)
+
+func TestSprayAnalyzer(t *testing.T) {
+	parser := fake.NewParser()
+	parser.On("TickRate").Return(64.0)
+
+	a := NewSprayAnalyzer(parser)
+	shooter := newPlayer(common.TeamTerrorists)
+
+	a.addBullet(shooter, common.EqAK47, 2, SprayBullet{Tick: 0, ViewAngles: r3.Vector{Y: 359}, Speed: 100})
+	a.hit(shooter, common.EqAK47, 0)
+	a.hit(shooter, common.EqAK47, 0) // e.g. wallbang through two players, only counted once
+
+	// perfectly compensated
+	a.addBullet(shooter, common.EqAK47, 2, SprayBullet{Tick: 6, ViewAngles: r3.Vector{X: 1, Y: 1}, AimPunch: r3.Vector{X: -0.5, Y: -1}})
+	// compensated too little
+	a.addBullet(shooter, common.EqAK47, 2, SprayBullet{Tick: 12, ViewAngles: r3.Vector{X: 1, Y: 1}, AimPunch: r3.Vector{X: -1, Y: -1}})
+	a.hit(shooter, common.EqM4A4, 12)
+
+	// too long after the previous shot
+	a.addBullet(shooter, common.EqAK47, 2, SprayBullet{Tick: 40})
+
+	sprays := a.Sprays()
+	if assert.Len(t, sprays, 2) {
+		spray := sprays[0]
+		assert.Equal(t, shooter, spray.Shooter)
+		assert.Equal(t, common.EqAK47, spray.Weapon)
+		assert.Equal(t, 2, spray.Round)
+		assert.True(t, spray.FirstBulletMoving())
+		assert.Len(t, spray.Bullets, 3)
+		assert.True(t, spray.Bullets[0].Hit)
+		assert.False(t, spray.Bullets[2].Hit)
+		assert.Equal(t, r3.Vector{X: 1, Y: 2}, spray.Bullets[1].ViewDelta)
+		assert.InDelta(t, 0, spray.Bullets[1].CompensationError, 0.001)
+		assert.InDelta(t, 1, spray.Bullets[2].CompensationError, 0.001)
+		assert.InDelta(t, 0.5, spray.AverageCompensationError(), 0.001)
+
+		assert.False(t, sprays[1].FirstBulletMoving())
+		assert.Zero(t, sprays[1].AverageCompensationError())
+	}
+
+	stats := a.Stats(shooter, common.EqAK47)
+	assert.Equal(t, []*SprayStats{stats}, a.AllStats())
+	assert.Equal(t, 2, stats.Sprays)
+	assert.Equal(t, 4, stats.Bullets)
+	assert.Equal(t, 1, stats.FirstBulletHits)
+	assert.Equal(t, 1, stats.FirstBulletsMoving)
+	assert.Equal(t, 0.5, stats.FirstBulletAccuracy())
+	assert.Equal(t, 0.5, stats.FirstBulletsMovingRatio())
+	assert.InDelta(t, 0.5, stats.AverageCompensationError(), 0.001)
+}
+
+func TestSprayAnalyzer_NewSpray(t *testing.T) {
+	parser := fake.NewParser()
+	parser.On("TickRate").Return(64.0)
+
+	a := NewSprayAnalyzer(parser)
+	shooter := newPlayer(common.TeamTerrorists)
+
+	a.addBullet(shooter, common.EqAK47, 0, SprayBullet{Tick: 0})
+	a.addBullet(shooter, common.EqDeagle, 0, SprayBullet{Tick: 4}) // weapon switch
+	a.addBullet(shooter, common.EqDeagle, 1, SprayBullet{Tick: 8}) // next round
+	a.onRoundStart(events.RoundStart{})
+	a.addBullet(shooter, common.EqDeagle, 1, SprayBullet{Tick: 12})
+
+	assert.Len(t, a.Sprays(), 4)
+	assert.Equal(t, 3, a.Stats(shooter, common.EqDeagle).Sprays)
+}
+
+func TestSprayAnalyzer_IgnoresNonFirearms(t *testing.T) {
+	a := NewSprayAnalyzer(fake.NewParser())
+
+	a.onWeaponFire(events.WeaponFire{Shooter: newPlayer(common.TeamTerrorists), Weapon: common.NewEquipment(common.EqHE)})
+	a.onWeaponFire(events.WeaponFire{Weapon: common.NewEquipment(common.EqAK47)})
+
+	assert.Empty(t, a.Sprays())
+}
+
+func TestSprayStats_Averages(t *testing.T) {
+	var s SprayStats
+
+	assert.Zero(t, s.FirstBulletAccuracy())
+	assert.Zero(t, s.FirstBulletsMovingRatio())
+	assert.Zero(t, s.AverageCompensationError())
+}
@@ -153,6 +153,7 @@ type demoInfoProviderMock struct {
 	playersByHandle  map[uint64]*Player
 	entitiesByHandle map[uint64]st.Entity
 	equipment        *Equipment
+	velocity         r3.Vector
 }
 
 func (p demoInfoProviderMock) FindEntityByHandle(handle uint64) st.Entity {
@@ -179,6 +180,10 @@ func (p demoInfoProviderMock) FindWeaponByEntityID(id int) *Equipment {
 	return p.equipment
 }
 
+func (p demoInfoProviderMock) EstimatedVelocity(*Player) r3.Vector {
+	return p.velocity
+}
+
 func mockDemoInfoProvider(tickRate float64, tick int) demoInfoProvider {
 	return demoInfoProviderMock{
 		tickRate:   tickRate,
@@ -1,6 +1,10 @@
 package common
 
-import st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+import (
+	"github.com/golang/geo/r3"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
 
 func getInt(entity st.Entity, propName string) int {
 	if entity == nil {
@@ -41,3 +45,17 @@ func getBool(entity st.Entity, propName string) bool {
 
 	return entity.PropertyValueMust(propName).BoolVal()
 }
+
+// getR3Vec returns the vector value of the property or a zero vector if the entity or property don't exist.
+func getR3Vec(entity st.Entity, propName string) r3.Vector {
+	if entity == nil {
+		return r3.Vector{}
+	}
+
+	val, ok := entity.PropertyValue(propName)
+	if !ok || val.Any == nil {
+		return r3.Vector{}
+	}
+
+	return val.R3Vec()
+}
@@ -41,6 +41,62 @@ func (e EquipmentType) String() string {
 	return eqElementToName[e]
 }
 
+// MaxSpeed returns the maximum movement speed (units per second) of a player holding the equipment.
+func (e EquipmentType) MaxSpeed() float64 {
+	if speed, ok := maxSpeeds[e]; ok {
+		return speed
+	}
+
+	return defaultMaxSpeed
+}
+
+// AccurateSpeed returns the movement speed (units per second) up to which shots with the weapon are fully accurate.
+func (e EquipmentType) AccurateSpeed() float64 {
+	return e.MaxSpeed() * accurateSpeedFactor
+}
+
+const (
+	defaultMaxSpeed     = 250.0 // Maximum movement speed with knives, grenades and unknown equipment
+	accurateSpeedFactor = 0.34  // Fraction of the maximum movement speed up to which shots are fully accurate
+)
+
+var maxSpeeds = map[EquipmentType]float64{
+	EqP2000:        240,
+	EqGlock:        240,
+	EqP250:         240,
+	EqDeagle:       230,
+	EqFiveSeven:    240,
+	EqDualBerettas: 240,
+	EqTec9:         240,
+	EqCZ:           240,
+	EqUSP:          240,
+	EqRevolver:     220,
+	EqMP7:          220,
+	EqMP9:          240,
+	EqBizon:        240,
+	EqMac10:        240,
+	EqUMP:          230,
+	EqP90:          230,
+	EqMP5:          235,
+	EqSawedOff:     210,
+	EqNova:         220,
+	EqSwag7:        225,
+	EqXM1014:       215,
+	EqM249:         195,
+	EqNegev:        150,
+	EqGalil:        215,
+	EqFamas:        220,
+	EqAK47:         215,
+	EqM4A4:         225,
+	EqM4A1:         225,
+	EqScout:        230,
+	EqSG556:        210,
+	EqAUG:          220,
+	EqAWP:          200,
+	EqScar20:       215,
+	EqG3SG1:        215,
+}
+
 // EquipmentType constants give information about what weapon a player has equipped.
 const (
 	EqUnknown EquipmentType = 0
@@ -334,6 +390,16 @@ func (e *Equipment) Class() EquipmentClass {
 	return e.Type.Class()
 }
 
+// IsFirearm returns true if the equipment is a pistol, SMG, heavy weapon or rifle.
+func (e *Equipment) IsFirearm() bool {
+	switch e.Class() {
+	case EqClassPistols, EqClassSMG, EqClassHeavy, EqClassRifle:
+		return true
+	}
+
+	return false
+}
+
 // UniqueID2 returns a unique id of the equipment element that can be sorted efficiently.
 // UniqueID2 is a value generated internally by this library and can be used to differentiate
 // equipment from each other. This is needed because demo-files reuse entity ids.
@@ -19,6 +19,13 @@ func TestEquipmentElement_Name(t *testing.T) {
 	assert.Equal(t, "Dual Berettas", EqDualBerettas.String(), "EqDualBerettas should be named correctly")
 }
 
+func TestEquipmentElement_AccurateSpeed(t *testing.T) {
+	assert.Equal(t, 215.0, EqAK47.MaxSpeed())
+	assert.InDelta(t, 73.1, EqAK47.AccurateSpeed(), 0.001)
+	assert.Equal(t, 250.0, EqKnife.MaxSpeed())
+	assert.InDelta(t, 85, EqKnife.AccurateSpeed(), 0.001)
+}
+
 func TestMapEquipment(t *testing.T) {
 	assert.Equal(t, EqKnife, MapEquipment("weapon_bayonet"), "'weapon_bayonet' should be mapped to EqKnife")
 	assert.Equal(t, EqKnife, MapEquipment("weapon_knife_butterfly"), "'weapon_knife_butterfly' should be mapped to EqKnife")
@@ -44,6 +51,17 @@ func TestEquipment_Class(t *testing.T) {
 	assert.Equal(t, EqClassRifle, NewEquipment(EqG3SG1).Class(), "EqG3SG1 should have the class EqClassRifle")
 }
 
+func TestEquipment_IsFirearm(t *testing.T) {
+	assert.True(t, NewEquipment(EqGlock).IsFirearm())
+	assert.True(t, NewEquipment(EqMP9).IsFirearm())
+	assert.True(t, NewEquipment(EqNegev).IsFirearm())
+	assert.True(t, NewEquipment(EqAWP).IsFirearm())
+	assert.False(t, NewEquipment(EqKnife).IsFirearm())
+	assert.False(t, NewEquipment(EqZeus).IsFirearm())
+	assert.False(t, NewEquipment(EqFlash).IsFirearm())
+	assert.False(t, NewEquipment(EqBomb).IsFirearm())
+}
+
 func TestEquipment_UniqueID(t *testing.T) {
 	assert.NotEqual(t, NewEquipment(EqAK47).UniqueID2(), NewEquipment(EqAK47).UniqueID2(), "UniqueIDs of different equipment instances should be different")
 }
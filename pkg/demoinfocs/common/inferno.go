@@ -2,6 +2,7 @@ package common
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"sort"
 
@@ -130,6 +131,26 @@ func (f Fires) ConvexHull2D() []r2.Point {
 	return points
 }
 
+// Area2D returns the area covered by the 2D convex hull of all the fires in square world units.
+// Returns 0 if there are less than 3 fires.
+func (f Fires) Area2D() float64 {
+	if len(f.s) < 3 {
+		return 0
+	}
+
+	hull := f.ConvexHull2D()
+
+	var area float64
+
+	// shoelace formula
+	for i := range hull {
+		j := (i + 1) % len(hull)
+		area += hull[i].X*hull[j].Y - hull[j].X*hull[i].Y
+	}
+
+	return math.Abs(area) / 2
+}
+
 // pointsClockwiseSorter implements the Sort interface for slices of Point
 // with a comparator for sorting points in clockwise order around their center.
 type pointsClockwiseSorter struct {
@@ -144,3 +144,18 @@ func TestInferno_List(t *testing.T) {
 	got := fires.List()
 	assert.ElementsMatch(t, expected, got, "List() should return the fires contained in Fires")
 }
+
+func TestFires_Area2D(t *testing.T) {
+	inf := Fires{
+		s: []Fire{
+			{Vector: r3.Vector{X: 0, Y: 0, Z: 3}},
+			{Vector: r3.Vector{X: 10, Y: 0, Z: 6}},
+			{Vector: r3.Vector{X: 10, Y: 10, Z: 9}},
+			{Vector: r3.Vector{X: 0, Y: 10, Z: 12}},
+			{Vector: r3.Vector{X: 5, Y: 5, Z: 1}},
+		},
+	}
+
+	assert.InDelta(t, 100, inf.Area2D(), 0.0001)
+	assert.Zero(t, Fires{s: inf.s[:2]}.Area2D())
+}
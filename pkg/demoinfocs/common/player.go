@@ -1,6 +1,7 @@
 package common
 
 import (
+	"math"
 	"time"
 
 	"github.com/golang/geo/r3"
@@ -30,6 +31,7 @@ type Player struct {
 	IsPlanting    bool
 	IsReloading   bool
 	IsUnknown     bool // Used to identify unknown/broken players. see https://github.com/markus-wa/demoinfocs-golang/issues/162
+	IsHLTV        bool // True if this is the GOTV / CSTV client. See also Role().
 }
 
 func (p *Player) PlayerPawnEntity() st.Entity {
@@ -290,6 +292,65 @@ func (p *Player) HasHelmet() bool {
 	return getBool(p.PlayerPawnEntity(), "m_pItemServices.m_bHasHelmet")
 }
 
+// PlayerRole is the type for the various PlayerRoleXYZ constants.
+type PlayerRole byte
+
+// PlayerRole constants give information about how a participant takes part in the match.
+const (
+	PlayerRolePlayer    PlayerRole = iota // Human player playing for a team
+	PlayerRoleCoach                       // Coach of a team, see CoachingTeam()
+	PlayerRoleSpectator                   // Spectator, caster or observer, also used for players that haven't joined a team yet
+	PlayerRoleHLTV                        // GOTV / CSTV client
+	PlayerRoleBot                         // Bot playing for a team
+)
+
+// Role returns how the participant takes part in the match.
+// Coaches may be assigned to the team they are coaching, so Team alone isn't enough to tell players and coaches apart.
+func (p *Player) Role() PlayerRole {
+	switch {
+	case p.IsHLTV:
+		return PlayerRoleHLTV
+	case p.IsCoach():
+		return PlayerRoleCoach
+	case p.Team == TeamSpectators || p.Team == TeamUnassigned:
+		return PlayerRoleSpectator
+	case p.IsBot:
+		return PlayerRoleBot
+	}
+
+	return PlayerRolePlayer
+}
+
+// CoachingTeam returns the team that the player is coaching.
+// Returns TeamUnassigned if the player isn't a coach.
+func (p *Player) CoachingTeam() Team {
+	if p.Entity == nil {
+		return TeamUnassigned
+	}
+
+	val, ok := p.Entity.PropertyValue("m_iCoachingTeam")
+	if !ok {
+		return TeamUnassigned
+	}
+
+	switch v := val.Any.(type) {
+	case int32:
+		return Team(v)
+	case uint32:
+		return Team(v)
+	case uint64:
+		return Team(v)
+	}
+
+	return TeamUnassigned
+}
+
+// IsCoach returns true if the player is coaching a team.
+// See also CoachingTeam().
+func (p *Player) IsCoach() bool {
+	return p.CoachingTeam() != TeamUnassigned
+}
+
 // IsControllingBot returns true if the player is currently controlling a bot.
 // See also ControlledBot().
 func (p *Player) IsControllingBot() bool {
@@ -308,6 +369,72 @@ func (p *Player) ControlledBot() *Player {
 	return p.demoInfoProvider.FindPlayerByHandle(controllerHandler)
 }
 
+// ObserverMode is the type for the various ObserverModeXYZ constants.
+type ObserverMode byte
+
+// ObserverMode constants give information about the camera mode of a spectating player.
+const (
+	ObserverModeNone     ObserverMode = 0 // Not observing
+	ObserverModeFixed    ObserverMode = 1 // Fixed camera position
+	ObserverModeInEye    ObserverMode = 2 // First person view of the target
+	ObserverModeChase    ObserverMode = 3 // Third person view following the target
+	ObserverModeRoaming  ObserverMode = 4 // Free camera
+	ObserverModeDirected ObserverMode = 5 // Camera controlled by the GOTV / CSTV director
+)
+
+// currentPawnEntity returns the pawn the player currently controls (m_hPawn).
+// Unlike PlayerPawnEntity() this is the observer pawn while the player is spectating.
+func (p *Player) currentPawnEntity() st.Entity {
+	if p.Entity == nil {
+		return nil
+	}
+
+	pawn, exists := p.Entity.PropertyValue("m_hPawn")
+	if !exists || pawn.Handle() == constants.InvalidEntityHandleSource2 {
+		return nil
+	}
+
+	return p.demoInfoProvider.FindEntityByHandle(pawn.Handle())
+}
+
+// ObserverMode returns the camera mode of the player while spectating or dead.
+// Returns ObserverModeNone if the player isn't observing anyone.
+func (p *Player) ObserverMode() ObserverMode {
+	pawn := p.currentPawnEntity()
+	if pawn == nil {
+		return ObserverModeNone
+	}
+
+	val, exists := pawn.PropertyValue("m_pObserverServices.m_iObserverMode")
+	if !exists {
+		return ObserverModeNone
+	}
+
+	return ObserverMode(val.UInt64())
+}
+
+// ObserverTarget returns the player that the player's camera is following while spectating or dead.
+// Returns nil if the player isn't observing anyone (e.g. while alive or with a free camera).
+// See also events.ObserverTargetChanged.
+func (p *Player) ObserverTarget() *Player {
+	pawn := p.currentPawnEntity()
+	if pawn == nil {
+		return nil
+	}
+
+	val, exists := pawn.PropertyValue("m_pObserverServices.m_hObserverTarget")
+	if !exists || val.Handle() == constants.InvalidEntityHandleSource2 {
+		return nil
+	}
+
+	target := p.demoInfoProvider.FindPlayerByPawnHandle(val.Handle())
+	if target == p {
+		return nil
+	}
+
+	return target
+}
+
 // Health returns the player's health points, normally 0-100.
 func (p *Player) Health() int {
 	return getInt(p.PlayerPawnEntity(), "m_iHealth")
@@ -400,6 +527,95 @@ func (p *Player) Position() r3.Vector {
 	return r3.Vector{}
 }
 
+const (
+	eyeHeightStanding = 64 // Distance between a standing player's feet and eyes
+	eyeHeightDucking  = 46 // Distance between a ducking player's feet and eyes
+)
+
+// PositionEyes returns the approximate in-game coordinates of the player's eyes.
+// The eye height is derived from Position() and whether the player is ducking.
+func (p *Player) PositionEyes() r3.Vector {
+	pos := p.Position()
+
+	if p.IsDucking() {
+		pos.Z += eyeHeightDucking
+	} else {
+		pos.Z += eyeHeightStanding
+	}
+
+	return pos
+}
+
+// ViewDirection returns the unit vector in which the player is looking.
+// See also ViewDirectionX() and ViewDirectionY().
+func (p *Player) ViewDirection() r3.Vector {
+	return AnglesToDirection(float64(p.ViewDirectionY()), float64(p.ViewDirectionX()))
+}
+
+// AnglesToDirection returns the unit vector for a pitch and yaw in degrees, e.g. of ViewDirectionY() and ViewDirectionX().
+// Positive pitch values point downwards.
+func AnglesToDirection(pitch, yaw float64) r3.Vector {
+	pitch *= math.Pi / 180
+	yaw *= math.Pi / 180
+
+	return r3.Vector{
+		X: math.Cos(pitch) * math.Cos(yaw),
+		Y: math.Cos(pitch) * math.Sin(yaw),
+		Z: -math.Sin(pitch),
+	}
+}
+
+// Velocity returns the player's velocity in units per second, as of the last tick.
+// Uses m_vecVelocity or m_vecAbsVelocity if available, otherwise the velocity is estimated
+// from the position change between the last two frames.
+func (p *Player) Velocity() r3.Vector {
+	pawnEntity := p.PlayerPawnEntity()
+	if pawnEntity == nil {
+		return r3.Vector{}
+	}
+
+	if vel, ok := velocityProperty(pawnEntity); ok {
+		return vel
+	}
+
+	return p.demoInfoProvider.EstimatedVelocity(p)
+}
+
+func velocityProperty(pawnEntity st.Entity) (r3.Vector, bool) {
+	for _, propName := range []string{"m_vecVelocity", "m_vecAbsVelocity"} {
+		if val, ok := pawnEntity.PropertyValue(propName); ok && val.Any != nil {
+			return val.R3Vec(), true
+		}
+	}
+
+	return r3.Vector{}, false
+}
+
+// Speed2D returns the player's horizontal speed in units per second, as of the last tick.
+// This is the speed that affects weapon accuracy.
+func (p *Player) Speed2D() float64 {
+	vel := p.Velocity()
+
+	return math.Hypot(vel.X, vel.Y)
+}
+
+// IsMovingAccurately returns true if the player is moving slow enough to shoot the active weapon accurately,
+// e.g. after counter-strafing. See EquipmentType.AccurateSpeed().
+func (p *Player) IsMovingAccurately() bool {
+	weapon := EqUnknown
+	if wep := p.ActiveWeapon(); wep != nil {
+		weapon = wep.Type
+	}
+
+	return p.Speed2D() <= weapon.AccurateSpeed()
+}
+
+// AimPunchAngle returns the player's aim punch (the view-kick caused by recoil or taking damage) in degrees.
+// X = pitch, Y = yaw, Z = roll.
+func (p *Player) AimPunchAngle() r3.Vector {
+	return getR3Vec(p.PlayerPawnEntity(), "m_aimPunchAngle")
+}
+
 // see https://github.com/ValveSoftware/source-sdk-2013/blob/master/mp/src/public/const.h#L146-L188
 const (
 	flOnGround = 1 << iota
@@ -582,6 +798,7 @@ type demoInfoProvider interface {
 	FindPlayerByPawnHandle(handle uint64) *Player
 	FindWeaponByEntityID(id int) *Equipment
 	FindEntityByHandle(handle uint64) st.Entity
+	EstimatedVelocity(player *Player) r3.Vector // velocity estimated from position changes, used for Player.Velocity()
 }
 
 // NewPlayer creates a *Player with an initialized equipment map.
@@ -0,0 +1,78 @@
+package common
+
+// ConnectionInterval is a period during which a player was connected to the server.
+type ConnectionInterval struct {
+	Player    *Player // Player instance of the connection, may differ between connections of the same identity
+	StartTick int     // Ingame tick of the connection
+	EndTick   int     // Ingame tick of the disconnection, -1 if the player is still connected
+}
+
+// BotControlInterval is a period during which a player controlled a bot.
+type BotControlInterval struct {
+	Bot       *Player
+	StartTick int // Ingame tick at which the player took over the bot
+	EndTick   int // Ingame tick at which the player stopped controlling the bot, -1 if still controlling it
+}
+
+// PlayerIdentity is a stable handle of a human player, identified by their SteamID64.
+// Unlike Player it stays the same if the player reconnects and gets a new UserID or Player instance.
+type PlayerIdentity struct {
+	SteamID64   uint64
+	Player      *Player // Player instance of the latest connection
+	Connections []ConnectionInterval
+	BotControl  []BotControlInterval // Periods during which the player controlled a bot, see Player.ControlledBot()
+}
+
+// IsConnected returns true if the player is currently connected.
+func (id *PlayerIdentity) IsConnected() bool {
+	return len(id.Connections) > 0 && id.Connections[len(id.Connections)-1].EndTick == -1
+}
+
+// ConnectedTicks returns the total amount of ticks the player was connected until currentTick.
+func (id *PlayerIdentity) ConnectedTicks(currentTick int) int {
+	total := 0
+
+	for _, c := range id.Connections {
+		total += intervalTicks(c.StartTick, c.EndTick, currentTick)
+	}
+
+	return total
+}
+
+// BotControlTicks returns the total amount of ticks the player controlled bots until currentTick.
+func (id *PlayerIdentity) BotControlTicks(currentTick int) int {
+	total := 0
+
+	for _, c := range id.BotControl {
+		total += intervalTicks(c.StartTick, c.EndTick, currentTick)
+	}
+
+	return total
+}
+
+// ControlledBotAt returns the bot that the player controlled at the given tick.
+// Returns nil if the player didn't control a bot at that time.
+//
+// This can be used to attribute actions of bots (e.g. kills) to the player controlling them.
+func (id *PlayerIdentity) ControlledBotAt(tick int) *Player {
+	for _, c := range id.BotControl {
+		if tick >= c.StartTick && (c.EndTick == -1 || tick < c.EndTick) {
+			return c.Bot
+		}
+	}
+
+	return nil
+}
+
+// intervalTicks returns the duration of an interval in ticks, where endTick -1 means the interval hasn't ended yet.
+func intervalTicks(startTick, endTick, currentTick int) int {
+	if endTick == -1 {
+		endTick = currentTick
+	}
+
+	if endTick < startTick {
+		return 0
+	}
+
+	return endTick - startTick
+}
@@ -0,0 +1,41 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayerIdentity(t *testing.T) {
+	bot := new(Player)
+	id := &PlayerIdentity{
+		Connections: []ConnectionInterval{
+			{StartTick: 0, EndTick: 100},
+			{StartTick: 150, EndTick: -1},
+		},
+		BotControl: []BotControlInterval{
+			{Bot: bot, StartTick: 20, EndTick: 50},
+			{Bot: bot, StartTick: 180, EndTick: -1},
+		},
+	}
+
+	assert.True(t, id.IsConnected())
+	assert.Equal(t, 150, id.ConnectedTicks(200))
+	assert.Equal(t, 50, id.BotControlTicks(200))
+	assert.Equal(t, bot, id.ControlledBotAt(20))
+	assert.Nil(t, id.ControlledBotAt(50))
+	assert.Equal(t, bot, id.ControlledBotAt(300))
+
+	id.Connections[1].EndTick = 250
+
+	assert.False(t, id.IsConnected())
+	assert.Equal(t, 200, id.ConnectedTicks(300))
+}
+
+func TestPlayerIdentity_NeverConnected(t *testing.T) {
+	id := new(PlayerIdentity)
+
+	assert.False(t, id.IsConnected())
+	assert.Zero(t, id.ConnectedTicks(100))
+	assert.Nil(t, id.ControlledBotAt(0))
+}
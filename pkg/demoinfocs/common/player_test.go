@@ -9,6 +9,7 @@ import (
 
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/constants"
 	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
 )
 
 func TestPlayerActiveWeapon(t *testing.T) {
@@ -191,6 +192,70 @@ func TestPlayer_IsInBuyZone(t *testing.T) {
 	assert.True(t, pl.IsInBuyZone())
 }
 
+func TestPlayer_Velocity(t *testing.T) {
+	pl := playerWithPawnProperty("m_vecVelocity", st.PropertyValue{Any: []float32{30, 40, 10}})
+
+	assert.Equal(t, r3.Vector{X: 30, Y: 40, Z: 10}, pl.Velocity())
+	assert.Equal(t, 50.0, pl.Speed2D())
+}
+
+func TestPlayer_Velocity_NilPawn(t *testing.T) {
+	pl := playerWithProperty("m_hPawn", st.PropertyValue{Any: uint64(constants.InvalidEntityHandleSource2)})
+
+	assert.Equal(t, r3.Vector{}, pl.Velocity())
+	assert.Zero(t, pl.Speed2D())
+}
+
+func TestPlayer_Velocity_AbsVelocity(t *testing.T) {
+	pl := playerWithPawnProperties([]fakeProp{
+		{propName: "m_vecVelocity", value: st.PropertyValue{}},
+		{propName: "m_vecAbsVelocity", value: st.PropertyValue{Any: []float32{3, 4, 0}}},
+	})
+
+	assert.Equal(t, r3.Vector{X: 3, Y: 4}, pl.Velocity())
+}
+
+func TestPlayer_Velocity_Estimated(t *testing.T) {
+	pawn := entityWithID(2)
+	pawn.On("PropertyValue", "m_vecVelocity").Return(st.PropertyValue{}, false)
+	pawn.On("PropertyValue", "m_vecAbsVelocity").Return(st.PropertyValue{}, false)
+
+	pl := playerWithProperty("m_hPawn", st.PropertyValue{Any: uint64(2)})
+	pl.Entity.(*stfake.Entity).On("PropertyValue", "m_hPlayerPawn").Return(st.PropertyValue{Any: uint64(2)}, true)
+	pl.demoInfoProvider = demoInfoProviderMock{
+		entitiesByHandle: map[uint64]st.Entity{2: pawn},
+		velocity:         r3.Vector{X: 256, Y: -128},
+	}
+
+	assert.Equal(t, r3.Vector{X: 256, Y: -128}, pl.Velocity())
+}
+
+func TestPlayer_IsMovingAccurately(t *testing.T) {
+	pl := playerWithPawnProperties([]fakeProp{
+		{propName: "m_vecVelocity", value: st.PropertyValue{Any: []float32{60, 40, 0}}},
+		{propName: "m_pWeaponServices.m_hActiveWeapon", value: st.PropertyValue{Any: uint64(3)}},
+	})
+	pl.demoInfoProvider = demoInfoProviderMock{
+		entitiesByHandle: pl.demoInfoProvider.(demoInfoProviderMock).entitiesByHandle,
+		equipment:        NewEquipment(EqAK47),
+	}
+
+	assert.True(t, pl.IsMovingAccurately())
+
+	pl.demoInfoProvider = demoInfoProviderMock{
+		entitiesByHandle: pl.demoInfoProvider.(demoInfoProviderMock).entitiesByHandle,
+		equipment:        NewEquipment(EqNegev),
+	}
+
+	assert.False(t, pl.IsMovingAccurately())
+}
+
+func TestPlayer_AimPunchAngle(t *testing.T) {
+	pl := playerWithPawnProperty("m_aimPunchAngle", st.PropertyValue{Any: []float32{-2, 0.5, 0}})
+
+	assert.Equal(t, r3.Vector{X: -2, Y: 0.5}, pl.AimPunchAngle())
+}
+
 func TestPlayer_IsWalking(t *testing.T) {
 	pl := playerWithPawnProperty("m_bIsWalking", st.PropertyValue{Any: true})
 
@@ -295,6 +360,40 @@ func TestPlayer_HasHelmet(t *testing.T) {
 	assert.True(t, pl.HasHelmet())
 }
 
+func TestPlayer_CoachingTeam(t *testing.T) {
+	assert.Equal(t, TeamUnassigned, new(Player).CoachingTeam())
+	assert.Equal(t, TeamUnassigned, playerWithProperty("m_iCoachingTeam", st.PropertyValue{Any: uint32(0)}).CoachingTeam())
+
+	coach := playerWithProperty("m_iCoachingTeam", st.PropertyValue{Any: uint32(3)})
+
+	assert.Equal(t, TeamCounterTerrorists, coach.CoachingTeam())
+	assert.True(t, coach.IsCoach())
+}
+
+func TestPlayer_Role(t *testing.T) {
+	notCoaching := st.PropertyValue{Any: uint32(0)}
+
+	pl := playerWithProperty("m_iCoachingTeam", notCoaching)
+	pl.Team = TeamTerrorists
+	assert.Equal(t, PlayerRolePlayer, pl.Role())
+
+	pl.IsBot = true
+	assert.Equal(t, PlayerRoleBot, pl.Role())
+
+	coach := playerWithProperty("m_iCoachingTeam", st.PropertyValue{Any: uint32(2)})
+	coach.Team = TeamTerrorists
+	assert.Equal(t, PlayerRoleCoach, coach.Role())
+
+	spectator := playerWithProperty("m_iCoachingTeam", notCoaching)
+	spectator.Team = TeamSpectators
+	assert.Equal(t, PlayerRoleSpectator, spectator.Role())
+
+	hltv := playerWithProperty("m_iCoachingTeam", notCoaching)
+	hltv.Team = TeamSpectators
+	hltv.IsHLTV = true
+	assert.Equal(t, PlayerRoleHLTV, hltv.Role())
+}
+
 func TestPlayer_IsControllingBot_NilEntity(t *testing.T) {
 	pl := new(Player)
 
@@ -339,6 +438,35 @@ func TestPlayer_ControlledBot(t *testing.T) {
 	assert.Same(t, dave, pl.ControlledBot())
 }
 
+func TestPlayer_ObserverTarget(t *testing.T) {
+	target := new(Player)
+	pawnHandle := uint64(5)
+
+	pl := &Player{
+		Entity: entityWithProperty("m_hPawn", st.PropertyValue{Any: pawnHandle}),
+		demoInfoProvider: demoInfoProviderMock{
+			entitiesByHandle: map[uint64]st.Entity{
+				pawnHandle: entityWithProperties([]fakeProp{
+					{propName: "m_pObserverServices.m_hObserverTarget", value: st.PropertyValue{Any: uint64(9)}},
+					{propName: "m_pObserverServices.m_iObserverMode", value: st.PropertyValue{Any: uint64(ObserverModeInEye)}},
+				}),
+			},
+			playersByHandle: map[uint64]*Player{9: target},
+		},
+	}
+
+	assert.Same(t, target, pl.ObserverTarget())
+	assert.Equal(t, ObserverModeInEye, pl.ObserverMode())
+}
+
+func TestPlayer_ObserverTarget_NotObserving(t *testing.T) {
+	pl := playerWithProperty("m_hPawn", st.PropertyValue{Any: uint64(constants.InvalidEntityHandleSource2)})
+
+	assert.Nil(t, pl.ObserverTarget())
+	assert.Equal(t, ObserverModeNone, pl.ObserverMode())
+	assert.Nil(t, new(Player).ObserverTarget())
+}
+
 func TestPlayer_ClanTag(t *testing.T) {
 	pl := playerWithProperty("m_szClan", st.PropertyValue{Any: "SuperClan"})
 
@@ -519,3 +647,30 @@ func playerWithPawnProperty(propName string, value st.PropertyValue) *Player {
 		value:    value,
 	}})
 }
+
+func TestPlayer_PositionEyes(t *testing.T) {
+	pl := playerWithPawnProperty("m_fFlags", st.PropertyValue{Any: uint64(0)})
+	pawn := pl.PlayerPawnEntity().(*stfake.Entity)
+	pawn.On("Position").Return(r3.Vector{X: 1, Y: 2, Z: 3})
+
+	assert.Equal(t, r3.Vector{X: 1, Y: 2, Z: 3 + eyeHeightStanding}, pl.PositionEyes())
+
+	pl = playerWithPawnProperty("m_fFlags", st.PropertyValue{Any: uint64(2)})
+	pawn = pl.PlayerPawnEntity().(*stfake.Entity)
+	pawn.On("Position").Return(r3.Vector{X: 1, Y: 2, Z: 3})
+
+	assert.Equal(t, r3.Vector{X: 1, Y: 2, Z: 3 + eyeHeightDucking}, pl.PositionEyes())
+}
+
+func TestPlayer_ViewDirection(t *testing.T) {
+	pl := playerWithPawnProperty("m_angEyeAngles", st.PropertyValue{Any: []float32{0, 90, 0}})
+
+	assert.InDelta(t, 0, pl.ViewDirection().X, 1e-9)
+	assert.InDelta(t, 1, pl.ViewDirection().Y, 1e-9)
+	assert.InDelta(t, 0, pl.ViewDirection().Z, 1e-9)
+
+	// looking straight down
+	pl = playerWithPawnProperty("m_angEyeAngles", st.PropertyValue{Any: []float32{90, 0, 0}})
+
+	assert.InDelta(t, -1, pl.ViewDirection().Z, 1e-9)
+}
@@ -0,0 +1,191 @@
+package common
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/golang/geo/r3"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+// Approximations used for the smoke model.
+// The actual CS2 smoke is a voxel volume that isn't fully networked, so these are rough values.
+const (
+	SmokeRadius       = 144.0           // Radius of a fully bloomed smoke cloud in world units
+	SmokeHoleRadius   = 128.0           // Radius of the hole an HE grenade blows into a smoke cloud
+	SmokeHoleDuration = 3 * time.Second // Time it takes for a hole to close again
+)
+
+// Smoke is an active smoke cloud.
+// The cloud is approximated as a sphere of SmokeRadius around the detonation position,
+// holes blown by HE grenades (see Holes) are approximated as spheres of SmokeHoleRadius.
+type Smoke struct {
+	Entity    st.Entity // The smoke grenade projectile, may be nil if the entity is not known
+	Thrower   *Player   // May be nil if the thrower is unknown
+	Position  r3.Vector // Center of the smoke cloud
+	StartTick int       // In-game tick at which the smoke popped
+
+	// Holes contains the deformations caused by HE grenades.
+	// Intended for internal use only, use ActiveHoles() instead.
+	Holes []SmokeHole
+
+	// uniqueID is used to distinguish different smokes (which potentially have the same, reused entityID) from each other.
+	uniqueID         int64
+	demoInfoProvider demoInfoProvider
+}
+
+// SmokeHole is a temporary deformation of a smoke cloud, caused by an HE grenade.
+type SmokeHole struct {
+	Position  r3.Vector
+	Radius    float64
+	StartTick int
+	EndTick   int
+}
+
+// UniqueID returns the unique id of the smoke.
+// The unique id is a random int generated internally by this library and can be used to differentiate
+// smokes from each other. This is needed because demo-files reuse entity ids.
+func (s *Smoke) UniqueID() int64 {
+	return s.uniqueID
+}
+
+// Radius returns the (approximated) radius of the smoke cloud.
+func (s *Smoke) Radius() float64 {
+	return SmokeRadius
+}
+
+// ActiveHoles returns the holes that currently deform the smoke cloud.
+func (s *Smoke) ActiveHoles() []SmokeHole {
+	tick := s.demoInfoProvider.IngameTick()
+	active := make([]SmokeHole, 0, len(s.Holes))
+
+	for _, h := range s.Holes {
+		if h.StartTick <= tick && tick < h.EndTick {
+			active = append(active, h)
+		}
+	}
+
+	return active
+}
+
+// Volume returns the approximate volume of the smoke cloud in cubic world units, taking active holes into account.
+func (s *Smoke) Volume() float64 {
+	volume := sphereVolume(s.Radius())
+
+	for _, h := range s.ActiveHoles() {
+		volume -= sphereIntersectionVolume(s.Radius(), h.Radius, s.Position.Distance(h.Position))
+	}
+
+	return math.Max(volume, 0)
+}
+
+// Contains returns true if the position is inside the smoke cloud and not inside an active hole.
+func (s *Smoke) Contains(pos r3.Vector) bool {
+	if pos.Distance(s.Position) > s.Radius() {
+		return false
+	}
+
+	for _, h := range s.ActiveHoles() {
+		if pos.Distance(h.Position) <= h.Radius {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IntersectsLine returns true if the line segment between a and b passes through the smoke cloud,
+// i.e. if any part of it is inside the smoke and not inside an active hole.
+func (s *Smoke) IntersectsLine(a, b r3.Vector) bool {
+	start, end, ok := segmentSphereIntersection(a, b, s.Position, s.Radius())
+	if !ok {
+		return false
+	}
+
+	var holes [][2]float64
+
+	for _, h := range s.ActiveHoles() {
+		if hStart, hEnd, hOk := segmentSphereIntersection(a, b, h.Position, h.Radius); hOk {
+			holes = append(holes, [2]float64{hStart, hEnd})
+		}
+	}
+
+	sort.Slice(holes, func(i, j int) bool {
+		return holes[i][0] < holes[j][0]
+	})
+
+	covered := start
+
+	for _, h := range holes {
+		if h[0] > covered {
+			return true
+		}
+
+		covered = math.Max(covered, h[1])
+		if covered >= end {
+			return false
+		}
+	}
+
+	return covered < end || len(holes) == 0
+}
+
+// segmentSphereIntersection returns the interval of the segment a->b (as fractions of its length) that is inside the sphere.
+func segmentSphereIntersection(a, b, center r3.Vector, radius float64) (start, end float64, ok bool) {
+	d := b.Sub(a)
+	f := a.Sub(center)
+
+	qa := d.Dot(d)
+	qb := 2 * f.Dot(d)
+	qc := f.Dot(f) - radius*radius
+
+	if qa == 0 {
+		// a == b
+		return 0, 0, qc <= 0
+	}
+
+	discriminant := qb*qb - 4*qa*qc
+	if discriminant < 0 {
+		return 0, 0, false
+	}
+
+	sqrtD := math.Sqrt(discriminant)
+	start = math.Max((-qb-sqrtD)/(2*qa), 0)
+	end = math.Min((-qb+sqrtD)/(2*qa), 1)
+
+	return start, end, start <= end
+}
+
+func sphereVolume(r float64) float64 {
+	return 4.0 / 3.0 * math.Pi * r * r * r
+}
+
+// sphereIntersectionVolume returns the volume of the intersection of two spheres with radii r1 & r2 and distance d.
+func sphereIntersectionVolume(r1, r2, d float64) float64 {
+	if d >= r1+r2 {
+		return 0
+	}
+
+	if d <= math.Abs(r1-r2) {
+		return sphereVolume(math.Min(r1, r2))
+	}
+
+	return math.Pi * (r1 + r2 - d) * (r1 + r2 - d) * (d*d + 2*d*r2 - 3*r2*r2 + 2*d*r1 + 6*r2*r1 - 3*r1*r1) / (12 * d)
+}
+
+// NewSmoke creates a smoke and sets the Unique-ID.
+//
+// Intended for internal use only.
+func NewSmoke(demoInfoProvider demoInfoProvider, entity st.Entity, thrower *Player, position r3.Vector, startTick int) *Smoke {
+	return &Smoke{
+		Entity:           entity,
+		Thrower:          thrower,
+		Position:         position,
+		StartTick:        startTick,
+		uniqueID:         rand.Int63(), //nolint:gosec
+		demoInfoProvider: demoInfoProvider,
+	}
+}
@@ -0,0 +1,54 @@
+package common
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSmoke_IntersectsLine(t *testing.T) {
+	smoke := NewSmoke(demoInfoProviderMock{}, nil, nil, r3.Vector{}, 0)
+
+	assert.True(t, smoke.IntersectsLine(r3.Vector{X: -500}, r3.Vector{X: 500}))
+	assert.True(t, smoke.IntersectsLine(r3.Vector{X: -500}, r3.Vector{}))
+	assert.True(t, smoke.IntersectsLine(r3.Vector{X: 10}, r3.Vector{X: 20}))
+	assert.False(t, smoke.IntersectsLine(r3.Vector{X: -500}, r3.Vector{X: -200}))
+	assert.False(t, smoke.IntersectsLine(r3.Vector{X: -500, Y: 200}, r3.Vector{X: 500, Y: 200}))
+}
+
+func TestSmoke_IntersectsLine_Holes(t *testing.T) {
+	smoke := NewSmoke(demoInfoProviderMock{ingameTick: 10}, nil, nil, r3.Vector{}, 0)
+	smoke.Holes = []SmokeHole{
+		{Position: r3.Vector{X: -100}, Radius: 100, StartTick: 5, EndTick: 15},
+		{Position: r3.Vector{X: 100}, Radius: 100, StartTick: 5, EndTick: 15},
+	}
+
+	assert.False(t, smoke.IntersectsLine(r3.Vector{X: -500}, r3.Vector{X: 500}), "holes cover the whole line")
+	assert.True(t, smoke.IntersectsLine(r3.Vector{X: -500, Y: 50}, r3.Vector{X: 500, Y: 50}), "holes don't cover the whole line")
+
+	smoke.demoInfoProvider = demoInfoProviderMock{ingameTick: 20}
+
+	assert.True(t, smoke.IntersectsLine(r3.Vector{X: -500}, r3.Vector{X: 500}), "holes closed again")
+}
+
+func TestSmoke_Volume(t *testing.T) {
+	smoke := NewSmoke(demoInfoProviderMock{ingameTick: 10}, nil, nil, r3.Vector{}, 0)
+	full := 4.0 / 3.0 * math.Pi * math.Pow(SmokeRadius, 3)
+
+	assert.InDelta(t, full, smoke.Volume(), 0.001)
+
+	smoke.Holes = []SmokeHole{{Position: r3.Vector{}, Radius: SmokeRadius / 2, StartTick: 5, EndTick: 15}}
+
+	assert.InDelta(t, full*7/8, smoke.Volume(), 0.001)
+}
+
+func TestSmoke_Contains(t *testing.T) {
+	smoke := NewSmoke(demoInfoProviderMock{ingameTick: 10}, nil, nil, r3.Vector{}, 0)
+	smoke.Holes = []SmokeHole{{Position: r3.Vector{X: 100}, Radius: 20, StartTick: 5, EndTick: 15}}
+
+	assert.True(t, smoke.Contains(r3.Vector{X: 50}))
+	assert.False(t, smoke.Contains(r3.Vector{X: 100}))
+	assert.False(t, smoke.Contains(r3.Vector{X: 200}))
+}
@@ -0,0 +1,41 @@
+package common
+
+// VoteIssue is the type for the various VoteIssueXYZ constants.
+type VoteIssue int
+
+// VoteIssue constants give information about what is being voted on.
+const (
+	VoteIssueUndefined        VoteIssue = -1
+	VoteIssueKick             VoteIssue = 0
+	VoteIssueChangeLevel      VoteIssue = 1
+	VoteIssueNextLevel        VoteIssue = 2
+	VoteIssueSwapTeams        VoteIssue = 3
+	VoteIssueScrambleTeams    VoteIssue = 4
+	VoteIssueRestartGame      VoteIssue = 5
+	VoteIssueSurrender        VoteIssue = 6
+	VoteIssueRematch          VoteIssue = 7
+	VoteIssueContinue         VoteIssue = 8
+	VoteIssuePauseMatch       VoteIssue = 9
+	VoteIssueUnpauseMatch     VoteIssue = 10
+	VoteIssueLoadBackup       VoteIssue = 11
+	VoteIssueEndWarmup        VoteIssue = 12
+	VoteIssueStartTimeout     VoteIssue = 13
+	VoteIssueEndTimeout       VoteIssue = 14
+	VoteIssueReadyForMatch    VoteIssue = 15
+	VoteIssueNotReadyForMatch VoteIssue = 16
+)
+
+// Vote represents an in-game vote (e.g. to kick a player, surrender or call a timeout).
+type Vote struct {
+	Issue          VoteIssue
+	DisplayString  string  // Localization token of the issue, e.g. "#SFUI_vote_kick_player_other"
+	Details        string  // Additional details, e.g. the name of the player that should be kicked
+	Issuer         *Player // nil if the vote was started by the server or the player is unknown
+	Target         *Player // Player that the vote is about (e.g. kick votes), may be nil
+	Team           Team    // Team that is allowed to vote, TeamUnassigned if everyone can vote
+	IsYesNoVote    bool
+	StartTick      int // Ingame tick at which the vote was started
+	YesVotes       int
+	NoVotes        int
+	PotentialVotes int // Number of players that are allowed to vote, 0 if unknown
+}
@@ -24,6 +24,8 @@ func (p *parser) bindEntities() {
 	p.bindBomb()
 	p.bindGameRules()
 	p.bindHostages()
+	p.bindPlayerPings()
+	p.bindVoteController()
 }
 
 func (p *parser) bindBomb() {
@@ -368,6 +370,7 @@ func (p *parser) getOrCreatePlayer(entityID int, rp *common.PlayerInfo) (isNew b
 
 	if rp != nil {
 		p.gameState.playersByUserID[userID] = player
+		player.IsHLTV = rp.IsHltv
 	}
 
 	return isNew, player
@@ -407,9 +410,8 @@ func (p *parser) bindNewPlayerController(controllerEntity st.Entity) {
 					delete(p.rawPlayers, k)
 				}
 			}
-			p.gameEventHandler.dispatch(events.PlayerDisconnected{
-				Player: pl,
-			})
+			p.gameState.identityDisconnected(pl)
+			p.dispatchPlayerDisconnected(pl)
 
 			return
 		}
@@ -417,7 +419,19 @@ func (p *parser) bindNewPlayerController(controllerEntity st.Entity) {
 		isConnection := !wasConnected && pl.IsConnected
 		if isConnection {
 			if pl.SteamID64 != 0 {
+				// a reason that arrived after the last PlayerDisconnected of this player must not be used for the next one
+				delete(p.disconnectReasons, pl.SteamID64)
+
+				identity, isReconnect := p.gameState.identityConnected(pl)
+
 				p.eventDispatcher.Dispatch(events.PlayerConnect{Player: pl})
+
+				if isReconnect {
+					p.eventDispatcher.Dispatch(events.PlayerReconnected{
+						Player:   pl,
+						Identity: identity,
+					})
+				}
 			} else {
 				p.eventDispatcher.Dispatch(events.BotConnect{Player: pl})
 			}
@@ -518,6 +532,93 @@ func (p *parser) bindNewPlayerPawn(pawnEntity st.Entity) {
 		spottedByMaskProp.OnUpdate(spottersChanged)
 		pawnEntity.Property("m_bSpottedByMask.0001").OnUpdate(spottersChanged)
 	}
+
+	if !p.disableMimicSource1GameEvents {
+		p.bindPlayerPawnStateEvents(pawnEntity, getPlayerFromPawnEntity)
+	}
+}
+
+// bindPlayerPawnStateEvents mimics game events that are only present in POV demos
+// from boolean properties of the player pawn (e.g. enter_buyzone / exit_buyzone).
+func (p *parser) bindPlayerPawnStateEvents(pawnEntity st.Entity, getPlayer func(st.Entity) *common.Player) {
+	onChange := func(propName string, handler func(pl *common.Player, val bool)) {
+		prop := pawnEntity.Property(propName)
+		if prop == nil {
+			return
+		}
+
+		var prev bool
+
+		prop.OnUpdate(func(val st.PropertyValue) {
+			newVal := val.Any != nil && val.BoolVal()
+			if newVal == prev {
+				return
+			}
+
+			prev = newVal
+
+			pl := getPlayer(pawnEntity)
+			if pl == nil {
+				return
+			}
+
+			handler(pl, newVal)
+		})
+	}
+
+	onChange("m_bInBombZone", func(pl *common.Player, inZone bool) {
+		if inZone {
+			p.eventDispatcher.Dispatch(events.PlayerEnteredBombZone{Player: pl})
+		} else {
+			p.eventDispatcher.Dispatch(events.PlayerLeftBombZone{Player: pl})
+		}
+	})
+
+	onChange("m_bInBuyZone", func(pl *common.Player, inZone bool) {
+		if inZone {
+			p.eventDispatcher.Dispatch(events.PlayerEnteredBuyZone{Player: pl})
+		} else {
+			p.eventDispatcher.Dispatch(events.PlayerLeftBuyZone{Player: pl})
+		}
+	})
+
+	onChange("m_bIsLookingAtWeapon", func(pl *common.Player, inspecting bool) {
+		if inspecting {
+			p.eventDispatcher.Dispatch(events.WeaponInspect{Player: pl})
+		}
+	})
+}
+
+// bindPlayerPings mimics player_ping game events, which are only present in POV demos.
+func (p *parser) bindPlayerPings() {
+	if p.disableMimicSource1GameEvents {
+		return
+	}
+
+	scPing := p.stParser.ServerClasses().FindByName("CPlayerPing")
+	if scPing == nil {
+		return
+	}
+
+	scPing.OnEntityCreated(func(entity st.Entity) {
+		var pl *common.Player
+
+		if playerProp, ok := entity.PropertyValue("m_hPlayer"); ok && playerProp.Any != nil {
+			pl = p.gameState.Participants().FindByPawnHandle(playerProp.Handle())
+			if pl == nil {
+				pl = p.gameState.Participants().FindByHandle64(playerProp.Handle())
+			}
+		}
+
+		urgentProp, ok := entity.PropertyValue("m_bUrgent")
+		urgent := ok && urgentProp.Any != nil && urgentProp.BoolVal()
+
+		p.eventDispatcher.Dispatch(events.PlayerPing{
+			Player:   pl,
+			Position: entity.Position(),
+			Urgent:   urgent,
+		})
+	})
 }
 
 func (p *parser) bindPlayerWeapons(pawnEntity st.Entity, pl *common.Player) {
@@ -794,6 +895,11 @@ func (p *parser) nadeProjectileDestroyed(proj *common.GrenadeProjectile) {
 		p.gameState.lastFlash.projectileByPlayer[proj.Owner] = proj
 	}
 
+	// Smokes are usually removed via smokegrenade_expired, but that's not always sent (e.g. at the end of the round)
+	if proj.WeaponInstance.Type == common.EqSmoke {
+		delete(p.gameState.smokes, proj.Entity.ID())
+	}
+
 	// We delete from the Owner.ThrownGrenades (only if not inferno or smoke, because they will be deleted when they expire)
 	isInferno := proj.WeaponInstance.Type == common.EqMolotov || proj.WeaponInstance.Type == common.EqIncendiary
 	isSmoke := proj.WeaponInstance.Type == common.EqSmoke
@@ -1075,6 +1181,7 @@ func (p *parser) bindGameRules() {
 				p.eventDispatcher.Dispatch(freezetimeEvent)
 			} else {
 				p.gameState.lastFreezeTimeChangedEvent = &freezetimeEvent
+				p.updateBuyTimeEnd(p.gameState.isFreezetime, newIsFreezetime)
 			}
 
 			p.gameState.isFreezetime = newIsFreezetime
@@ -1286,3 +1393,60 @@ func (p *parser) getClosestBombsiteFromPosition(position r3.Vector) events.Bombs
 
 	return events.BombsiteB
 }
+
+func (p *parser) bindVoteController() {
+	scVoteController := p.stParser.ServerClasses().FindByName("CVoteController")
+	if scVoteController == nil {
+		return
+	}
+
+	scVoteController.OnEntityCreated(func(entity st.Entity) {
+		p.gameState.voteController = entity
+
+		updateVoteCounts := func(st.PropertyValue) {
+			p.updateActiveVoteCounts()
+		}
+
+		for _, propName := range []string{"m_nVoteOptionCount.0000", "m_nVoteOptionCount.0001", "m_nPotentialVotes"} {
+			if prop := entity.Property(propName); prop != nil {
+				prop.OnUpdate(updateVoteCounts)
+			}
+		}
+
+		entity.OnDestroy(func() {
+			p.gameState.voteController = nil
+		})
+	})
+}
+
+// updateActiveVoteCounts copies the vote counts of the CVoteController entity to the active vote.
+func (p *parser) updateActiveVoteCounts() {
+	vote := p.gameState.activeVote
+	vc := p.gameState.voteController
+
+	if vote == nil || vc == nil {
+		return
+	}
+
+	count := func(propName string) int {
+		val, ok := vc.PropertyValue(propName)
+		if !ok {
+			return 0
+		}
+
+		switch v := val.Any.(type) {
+		case int32:
+			return int(v)
+		case uint32:
+			return int(v)
+		case uint64:
+			return int(v)
+		}
+
+		return 0
+	}
+
+	vote.YesVotes = count("m_nVoteOptionCount.0000")
+	vote.NoVotes = count("m_nVoteOptionCount.0001")
+	vote.PotentialVotes = count("m_nPotentialVotes")
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
 	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
 )
 
@@ -59,6 +60,7 @@ func configurePlayerEntityMock(id int, entity *stfake.Entity) {
 	})
 
 	entity.On("OnPositionUpdate", mock.Anything).Return()
+	entity.On("PropertyValue", "m_iCoachingTeam").Return(st.PropertyValue{}, false)
 	prop := new(stfake.Property)
 	prop.On("OnUpdate", mock.Anything).Return()
 	entity.On("Property", mock.Anything).Return(prop)
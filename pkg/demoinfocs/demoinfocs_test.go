@@ -449,6 +449,53 @@ func BenchmarkInMemory(b *testing.B) {
 	}
 }
 
+func BenchmarkEntitySelection(b *testing.B) {
+	f := openFile(b, s2DemPath)
+	defer mustClose(b, f)
+
+	d, err := io.ReadAll(f)
+	assert.NoError(b, err, "failed to read file %q", s2DemPath)
+
+	cases := []struct {
+		name      string
+		selection *demoinfocs.EntitySelection
+	}{
+		{name: "all"},
+		{name: "deny-cosmetics", selection: &demoinfocs.EntitySelection{
+			DenyClasses: []string{
+				"CEnvCubemap", "CEnvCubemapFog", "CEnvSky", "CEnvWind", "CFogController", "CPostProcessingVolume",
+				"CBaseModelEntity", "CDynamicProp", "CFuncBrush", "CRopeKeyframe", "CSpotlightEnd", "CSprite",
+				"CTonemapController2", "CCSRagdoll", "CBeam", "CParticleSystem",
+			},
+		}},
+		{name: "deny-econ-props", selection: &demoinfocs.EntitySelection{
+			// econ item properties of weapons that the parser doesn't read (it only needs m_iItemDefinitionIndex)
+			DenyPropertyPrefixes: []string{
+				"m_iItemIDHigh", "m_iItemIDLow", "m_iAccountID", "m_iEntityQuality", "m_iEntityLevel", "m_iInventoryPosition",
+				"m_bInitialized", "m_szCustomName", "m_AttributeList.", "m_NetworkedDynamicAttributes.",
+				"m_OriginalOwnerXuidLow", "m_OriginalOwnerXuidHigh", "m_nFallbackPaintKit", "m_nFallbackSeed",
+				"m_flFallbackWear", "m_nFallbackStatTrak",
+			},
+		}},
+	}
+
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			b.SetBytes(int64(len(d)))
+
+			for i := 0; i < b.N; i++ {
+				cfg := demoinfocs.DefaultParserConfig
+				cfg.EntitySelection = c.selection
+
+				p := demoinfocs.NewParserWithConfig(bytes.NewReader(d), cfg)
+
+				err := p.ParseToEnd()
+				assert.NoError(b, err, "ParseToEnd() returned an error")
+			}
+		})
+	}
+}
+
 func BenchmarkConcurrent(b *testing.B) {
 	b.Logf("Running concurrency benchmark with %d demos\n", *concurrentDemos)
 
@@ -0,0 +1,22 @@
+// Package entities contains typed wrappers for the entities of common server-classes.
+//
+// The wrappers look up all of their properties when they're bound to an entity,
+// so renamed or removed properties surface as errors (see ErrMissingProperty) instead of zero values or panics.
+//
+//	parser.ServerClasses().FindByName("CCSPlayerPawn").OnEntityCreated(func(entity st.Entity) {
+//		pawn, err := entities.BindCCSPlayerPawn(entity)
+//		if err != nil {
+//			panic(err)
+//		}
+//
+//		pawn.HealthProperty().OnUpdate(func(st.PropertyValue) {
+//			fmt.Println("health changed to", pawn.Health())
+//		})
+//	})
+//
+// The wrappers are generated from classes.txt by the generator in the gen directory,
+// which checks the properties against the schema of a test demo (see sendtables.Schema)
+// and writes the schema to schema.json, so changes of the server-classes show up in diffs.
+package entities
+
+//go:generate go run ./gen -spec classes.txt -o entities_gen.go -demo ../../../test/cs-demos/s2/s2.dem -dump-schema schema.json
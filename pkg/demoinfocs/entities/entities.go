@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/geo/r3"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+// ErrMissingProperty is returned when binding a wrapper to an entity that doesn't have all properties of the wrapper.
+// This usually means a property was renamed or removed in a game update.
+var ErrMissingProperty = errors.New("missing property")
+
+// binder looks up properties of an entity and collects errors for missing ones.
+type binder struct {
+	entity st.Entity
+	errs   []error
+}
+
+func newBinder(entity st.Entity) *binder {
+	return &binder{entity: entity}
+}
+
+func (b *binder) property(name string) st.Property {
+	prop := b.entity.Property(name)
+	if prop == nil {
+		b.errs = append(b.errs, fmt.Errorf("%w: %s.%s", ErrMissingProperty, b.entity.ServerClass().Name(), name))
+	}
+
+	return prop
+}
+
+func (b *binder) err() error {
+	return errors.Join(b.errs...)
+}
+
+// the value getters return zero values if the property doesn't have a value (yet)
+
+func intValue(prop st.Property) int {
+	v, _ := prop.Value().Any.(int32)
+
+	return int(v)
+}
+
+func uint32Value(prop st.Property) uint32 {
+	v, _ := prop.Value().Any.(uint32)
+
+	return v
+}
+
+func uint64Value(prop st.Property) uint64 {
+	v, _ := prop.Value().Any.(uint64)
+
+	return v
+}
+
+func float32Value(prop st.Property) float32 {
+	v, _ := prop.Value().Any.(float32)
+
+	return v
+}
+
+func boolValue(prop st.Property) bool {
+	v, _ := prop.Value().Any.(bool)
+
+	return v
+}
+
+func stringValue(prop st.Property) string {
+	v, _ := prop.Value().Any.(string)
+
+	return v
+}
+
+func vectorValue(prop st.Property) r3.Vector {
+	v, ok := prop.Value().Any.([]float32)
+	if !ok || len(v) < 3 {
+		return r3.Vector{}
+	}
+
+	return r3.Vector{X: float64(v[0]), Y: float64(v[1]), Z: float64(v[2])}
+}
@@ -0,0 +1,1483 @@
+// Code generated by gen/main.go from classes.txt. DO NOT EDIT.
+
+package entities
+
+import (
+	"github.com/golang/geo/r3"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+// CBaseTrigger is a typed wrapper for entities of the server-class CBaseTrigger.
+type CBaseTrigger struct {
+	Entity st.Entity
+
+	mins st.Property
+	maxs st.Property
+}
+
+// BindCBaseTrigger binds a CBaseTrigger wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCBaseTrigger(entity st.Entity) (*CBaseTrigger, error) {
+	b := newBinder(entity)
+
+	e := &CBaseTrigger{
+		Entity: entity,
+		mins:   b.property("m_vecMins"),
+		maxs:   b.property("m_vecMaxs"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Mins returns the value of the property m_vecMins.
+func (e *CBaseTrigger) Mins() r3.Vector {
+	return vectorValue(e.mins)
+}
+
+// MinsProperty returns the property m_vecMins.
+func (e *CBaseTrigger) MinsProperty() st.Property {
+	return e.mins
+}
+
+// Maxs returns the value of the property m_vecMaxs.
+func (e *CBaseTrigger) Maxs() r3.Vector {
+	return vectorValue(e.maxs)
+}
+
+// MaxsProperty returns the property m_vecMaxs.
+func (e *CBaseTrigger) MaxsProperty() st.Property {
+	return e.maxs
+}
+
+// CBombTarget is a typed wrapper for entities of the server-class CBombTarget.
+type CBombTarget struct {
+	Entity st.Entity
+
+	mins st.Property
+	maxs st.Property
+}
+
+// BindCBombTarget binds a CBombTarget wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCBombTarget(entity st.Entity) (*CBombTarget, error) {
+	b := newBinder(entity)
+
+	e := &CBombTarget{
+		Entity: entity,
+		mins:   b.property("m_vecMins"),
+		maxs:   b.property("m_vecMaxs"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Mins returns the value of the property m_vecMins.
+func (e *CBombTarget) Mins() r3.Vector {
+	return vectorValue(e.mins)
+}
+
+// MinsProperty returns the property m_vecMins.
+func (e *CBombTarget) MinsProperty() st.Property {
+	return e.mins
+}
+
+// Maxs returns the value of the property m_vecMaxs.
+func (e *CBombTarget) Maxs() r3.Vector {
+	return vectorValue(e.maxs)
+}
+
+// MaxsProperty returns the property m_vecMaxs.
+func (e *CBombTarget) MaxsProperty() st.Property {
+	return e.maxs
+}
+
+// CC4 is a typed wrapper for entities of the server-class CC4.
+type CC4 struct {
+	Entity st.Entity
+
+	ownerEntity   st.Property
+	startedArming st.Property
+}
+
+// BindCC4 binds a CC4 wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCC4(entity st.Entity) (*CC4, error) {
+	b := newBinder(entity)
+
+	e := &CC4{
+		Entity:        entity,
+		ownerEntity:   b.property("m_hOwnerEntity"),
+		startedArming: b.property("m_bStartedArming"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// OwnerEntity returns the value of the property m_hOwnerEntity.
+func (e *CC4) OwnerEntity() uint64 {
+	return uint64Value(e.ownerEntity)
+}
+
+// OwnerEntityProperty returns the property m_hOwnerEntity.
+func (e *CC4) OwnerEntityProperty() st.Property {
+	return e.ownerEntity
+}
+
+// StartedArming returns the value of the property m_bStartedArming.
+func (e *CC4) StartedArming() bool {
+	return boolValue(e.startedArming)
+}
+
+// StartedArmingProperty returns the property m_bStartedArming.
+func (e *CC4) StartedArmingProperty() st.Property {
+	return e.startedArming
+}
+
+// CCSGameRulesProxy is a typed wrapper for entities of the server-class CCSGameRulesProxy.
+type CCSGameRulesProxy struct {
+	Entity st.Entity
+
+	roundTime                 st.Property
+	mapHasRescueZone          st.Property
+	mapHasBombTarget          st.Property
+	freezePeriod              st.Property
+	warmupPeriod              st.Property
+	hasMatchStarted           st.Property
+	gamePhase                 st.Property
+	totalRoundsPlayed         st.Property
+	overtimePlaying           st.Property
+	roundWinReason            st.Property
+	terroristTimeOutActive    st.Property
+	ctTimeOutActive           st.Property
+	terroristTimeOutRemaining st.Property
+	ctTimeOutRemaining        st.Property
+	terroristTimeOuts         st.Property
+	ctTimeOuts                st.Property
+}
+
+// BindCCSGameRulesProxy binds a CCSGameRulesProxy wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCCSGameRulesProxy(entity st.Entity) (*CCSGameRulesProxy, error) {
+	b := newBinder(entity)
+
+	e := &CCSGameRulesProxy{
+		Entity:                    entity,
+		roundTime:                 b.property("m_pGameRules.m_iRoundTime"),
+		mapHasRescueZone:          b.property("m_pGameRules.m_bMapHasRescueZone"),
+		mapHasBombTarget:          b.property("m_pGameRules.m_bMapHasBombTarget"),
+		freezePeriod:              b.property("m_pGameRules.m_bFreezePeriod"),
+		warmupPeriod:              b.property("m_pGameRules.m_bWarmupPeriod"),
+		hasMatchStarted:           b.property("m_pGameRules.m_bHasMatchStarted"),
+		gamePhase:                 b.property("m_pGameRules.m_gamePhase"),
+		totalRoundsPlayed:         b.property("m_pGameRules.m_totalRoundsPlayed"),
+		overtimePlaying:           b.property("m_pGameRules.m_nOvertimePlaying"),
+		roundWinReason:            b.property("m_pGameRules.m_eRoundWinReason"),
+		terroristTimeOutActive:    b.property("m_pGameRules.m_bTerroristTimeOutActive"),
+		ctTimeOutActive:           b.property("m_pGameRules.m_bCTTimeOutActive"),
+		terroristTimeOutRemaining: b.property("m_pGameRules.m_flTerroristTimeOutRemaining"),
+		ctTimeOutRemaining:        b.property("m_pGameRules.m_flCTTimeOutRemaining"),
+		terroristTimeOuts:         b.property("m_pGameRules.m_nTerroristTimeOuts"),
+		ctTimeOuts:                b.property("m_pGameRules.m_nCTTimeOuts"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// RoundTime returns the value of the property m_pGameRules.m_iRoundTime.
+func (e *CCSGameRulesProxy) RoundTime() int {
+	return intValue(e.roundTime)
+}
+
+// RoundTimeProperty returns the property m_pGameRules.m_iRoundTime.
+func (e *CCSGameRulesProxy) RoundTimeProperty() st.Property {
+	return e.roundTime
+}
+
+// MapHasRescueZone returns the value of the property m_pGameRules.m_bMapHasRescueZone.
+func (e *CCSGameRulesProxy) MapHasRescueZone() bool {
+	return boolValue(e.mapHasRescueZone)
+}
+
+// MapHasRescueZoneProperty returns the property m_pGameRules.m_bMapHasRescueZone.
+func (e *CCSGameRulesProxy) MapHasRescueZoneProperty() st.Property {
+	return e.mapHasRescueZone
+}
+
+// MapHasBombTarget returns the value of the property m_pGameRules.m_bMapHasBombTarget.
+func (e *CCSGameRulesProxy) MapHasBombTarget() bool {
+	return boolValue(e.mapHasBombTarget)
+}
+
+// MapHasBombTargetProperty returns the property m_pGameRules.m_bMapHasBombTarget.
+func (e *CCSGameRulesProxy) MapHasBombTargetProperty() st.Property {
+	return e.mapHasBombTarget
+}
+
+// FreezePeriod returns the value of the property m_pGameRules.m_bFreezePeriod.
+func (e *CCSGameRulesProxy) FreezePeriod() bool {
+	return boolValue(e.freezePeriod)
+}
+
+// FreezePeriodProperty returns the property m_pGameRules.m_bFreezePeriod.
+func (e *CCSGameRulesProxy) FreezePeriodProperty() st.Property {
+	return e.freezePeriod
+}
+
+// WarmupPeriod returns the value of the property m_pGameRules.m_bWarmupPeriod.
+func (e *CCSGameRulesProxy) WarmupPeriod() bool {
+	return boolValue(e.warmupPeriod)
+}
+
+// WarmupPeriodProperty returns the property m_pGameRules.m_bWarmupPeriod.
+func (e *CCSGameRulesProxy) WarmupPeriodProperty() st.Property {
+	return e.warmupPeriod
+}
+
+// HasMatchStarted returns the value of the property m_pGameRules.m_bHasMatchStarted.
+func (e *CCSGameRulesProxy) HasMatchStarted() bool {
+	return boolValue(e.hasMatchStarted)
+}
+
+// HasMatchStartedProperty returns the property m_pGameRules.m_bHasMatchStarted.
+func (e *CCSGameRulesProxy) HasMatchStartedProperty() st.Property {
+	return e.hasMatchStarted
+}
+
+// GamePhase returns the value of the property m_pGameRules.m_gamePhase.
+func (e *CCSGameRulesProxy) GamePhase() int {
+	return intValue(e.gamePhase)
+}
+
+// GamePhaseProperty returns the property m_pGameRules.m_gamePhase.
+func (e *CCSGameRulesProxy) GamePhaseProperty() st.Property {
+	return e.gamePhase
+}
+
+// TotalRoundsPlayed returns the value of the property m_pGameRules.m_totalRoundsPlayed.
+func (e *CCSGameRulesProxy) TotalRoundsPlayed() int {
+	return intValue(e.totalRoundsPlayed)
+}
+
+// TotalRoundsPlayedProperty returns the property m_pGameRules.m_totalRoundsPlayed.
+func (e *CCSGameRulesProxy) TotalRoundsPlayedProperty() st.Property {
+	return e.totalRoundsPlayed
+}
+
+// OvertimePlaying returns the value of the property m_pGameRules.m_nOvertimePlaying.
+func (e *CCSGameRulesProxy) OvertimePlaying() int {
+	return intValue(e.overtimePlaying)
+}
+
+// OvertimePlayingProperty returns the property m_pGameRules.m_nOvertimePlaying.
+func (e *CCSGameRulesProxy) OvertimePlayingProperty() st.Property {
+	return e.overtimePlaying
+}
+
+// RoundWinReason returns the value of the property m_pGameRules.m_eRoundWinReason.
+func (e *CCSGameRulesProxy) RoundWinReason() int {
+	return intValue(e.roundWinReason)
+}
+
+// RoundWinReasonProperty returns the property m_pGameRules.m_eRoundWinReason.
+func (e *CCSGameRulesProxy) RoundWinReasonProperty() st.Property {
+	return e.roundWinReason
+}
+
+// TerroristTimeOutActive returns the value of the property m_pGameRules.m_bTerroristTimeOutActive.
+func (e *CCSGameRulesProxy) TerroristTimeOutActive() bool {
+	return boolValue(e.terroristTimeOutActive)
+}
+
+// TerroristTimeOutActiveProperty returns the property m_pGameRules.m_bTerroristTimeOutActive.
+func (e *CCSGameRulesProxy) TerroristTimeOutActiveProperty() st.Property {
+	return e.terroristTimeOutActive
+}
+
+// CTTimeOutActive returns the value of the property m_pGameRules.m_bCTTimeOutActive.
+func (e *CCSGameRulesProxy) CTTimeOutActive() bool {
+	return boolValue(e.ctTimeOutActive)
+}
+
+// CTTimeOutActiveProperty returns the property m_pGameRules.m_bCTTimeOutActive.
+func (e *CCSGameRulesProxy) CTTimeOutActiveProperty() st.Property {
+	return e.ctTimeOutActive
+}
+
+// TerroristTimeOutRemaining returns the value of the property m_pGameRules.m_flTerroristTimeOutRemaining.
+func (e *CCSGameRulesProxy) TerroristTimeOutRemaining() float32 {
+	return float32Value(e.terroristTimeOutRemaining)
+}
+
+// TerroristTimeOutRemainingProperty returns the property m_pGameRules.m_flTerroristTimeOutRemaining.
+func (e *CCSGameRulesProxy) TerroristTimeOutRemainingProperty() st.Property {
+	return e.terroristTimeOutRemaining
+}
+
+// CTTimeOutRemaining returns the value of the property m_pGameRules.m_flCTTimeOutRemaining.
+func (e *CCSGameRulesProxy) CTTimeOutRemaining() float32 {
+	return float32Value(e.ctTimeOutRemaining)
+}
+
+// CTTimeOutRemainingProperty returns the property m_pGameRules.m_flCTTimeOutRemaining.
+func (e *CCSGameRulesProxy) CTTimeOutRemainingProperty() st.Property {
+	return e.ctTimeOutRemaining
+}
+
+// TerroristTimeOuts returns the value of the property m_pGameRules.m_nTerroristTimeOuts.
+func (e *CCSGameRulesProxy) TerroristTimeOuts() int {
+	return intValue(e.terroristTimeOuts)
+}
+
+// TerroristTimeOutsProperty returns the property m_pGameRules.m_nTerroristTimeOuts.
+func (e *CCSGameRulesProxy) TerroristTimeOutsProperty() st.Property {
+	return e.terroristTimeOuts
+}
+
+// CTTimeOuts returns the value of the property m_pGameRules.m_nCTTimeOuts.
+func (e *CCSGameRulesProxy) CTTimeOuts() int {
+	return intValue(e.ctTimeOuts)
+}
+
+// CTTimeOutsProperty returns the property m_pGameRules.m_nCTTimeOuts.
+func (e *CCSGameRulesProxy) CTTimeOutsProperty() st.Property {
+	return e.ctTimeOuts
+}
+
+// CCSPlayerController is a typed wrapper for entities of the server-class CCSPlayerController.
+type CCSPlayerController struct {
+	Entity st.Entity
+
+	steamID                         st.Property
+	playerName                      st.Property
+	connected                       st.Property
+	teamNum                         st.Property
+	playerPawn                      st.Property
+	pawnIsAlive                     st.Property
+	controllingBot                  st.Property
+	originalControllerOfCurrentPawn st.Property
+	competitiveRankType             st.Property
+	competitiveRanking              st.Property
+	competitiveWins                 st.Property
+	clan                            st.Property
+	crosshairCodes                  st.Property
+	ping                            st.Property
+	score                           st.Property
+	mvPs                            st.Property
+	compTeammateColor               st.Property
+	account                         st.Property
+	totalCashSpent                  st.Property
+	cashSpentThisRound              st.Property
+	kills                           st.Property
+	deaths                          st.Property
+	assists                         st.Property
+}
+
+// BindCCSPlayerController binds a CCSPlayerController wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCCSPlayerController(entity st.Entity) (*CCSPlayerController, error) {
+	b := newBinder(entity)
+
+	e := &CCSPlayerController{
+		Entity:                          entity,
+		steamID:                         b.property("m_steamID"),
+		playerName:                      b.property("m_iszPlayerName"),
+		connected:                       b.property("m_iConnected"),
+		teamNum:                         b.property("m_iTeamNum"),
+		playerPawn:                      b.property("m_hPlayerPawn"),
+		pawnIsAlive:                     b.property("m_bPawnIsAlive"),
+		controllingBot:                  b.property("m_bControllingBot"),
+		originalControllerOfCurrentPawn: b.property("m_hOriginalControllerOfCurrentPawn"),
+		competitiveRankType:             b.property("m_iCompetitiveRankType"),
+		competitiveRanking:              b.property("m_iCompetitiveRanking"),
+		competitiveWins:                 b.property("m_iCompetitiveWins"),
+		clan:                            b.property("m_szClan"),
+		crosshairCodes:                  b.property("m_szCrosshairCodes"),
+		ping:                            b.property("m_iPing"),
+		score:                           b.property("m_iScore"),
+		mvPs:                            b.property("m_iMVPs"),
+		compTeammateColor:               b.property("m_iCompTeammateColor"),
+		account:                         b.property("m_pInGameMoneyServices.m_iAccount"),
+		totalCashSpent:                  b.property("m_pInGameMoneyServices.m_iTotalCashSpent"),
+		cashSpentThisRound:              b.property("m_pInGameMoneyServices.m_iCashSpentThisRound"),
+		kills:                           b.property("m_pActionTrackingServices.m_iKills"),
+		deaths:                          b.property("m_pActionTrackingServices.m_iDeaths"),
+		assists:                         b.property("m_pActionTrackingServices.m_iAssists"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// SteamID returns the value of the property m_steamID.
+func (e *CCSPlayerController) SteamID() uint64 {
+	return uint64Value(e.steamID)
+}
+
+// SteamIDProperty returns the property m_steamID.
+func (e *CCSPlayerController) SteamIDProperty() st.Property {
+	return e.steamID
+}
+
+// PlayerName returns the value of the property m_iszPlayerName.
+func (e *CCSPlayerController) PlayerName() string {
+	return stringValue(e.playerName)
+}
+
+// PlayerNameProperty returns the property m_iszPlayerName.
+func (e *CCSPlayerController) PlayerNameProperty() st.Property {
+	return e.playerName
+}
+
+// Connected returns the value of the property m_iConnected.
+func (e *CCSPlayerController) Connected() uint32 {
+	return uint32Value(e.connected)
+}
+
+// ConnectedProperty returns the property m_iConnected.
+func (e *CCSPlayerController) ConnectedProperty() st.Property {
+	return e.connected
+}
+
+// TeamNum returns the value of the property m_iTeamNum.
+func (e *CCSPlayerController) TeamNum() uint64 {
+	return uint64Value(e.teamNum)
+}
+
+// TeamNumProperty returns the property m_iTeamNum.
+func (e *CCSPlayerController) TeamNumProperty() st.Property {
+	return e.teamNum
+}
+
+// PlayerPawn returns the value of the property m_hPlayerPawn.
+func (e *CCSPlayerController) PlayerPawn() uint64 {
+	return uint64Value(e.playerPawn)
+}
+
+// PlayerPawnProperty returns the property m_hPlayerPawn.
+func (e *CCSPlayerController) PlayerPawnProperty() st.Property {
+	return e.playerPawn
+}
+
+// PawnIsAlive returns the value of the property m_bPawnIsAlive.
+func (e *CCSPlayerController) PawnIsAlive() bool {
+	return boolValue(e.pawnIsAlive)
+}
+
+// PawnIsAliveProperty returns the property m_bPawnIsAlive.
+func (e *CCSPlayerController) PawnIsAliveProperty() st.Property {
+	return e.pawnIsAlive
+}
+
+// ControllingBot returns the value of the property m_bControllingBot.
+func (e *CCSPlayerController) ControllingBot() bool {
+	return boolValue(e.controllingBot)
+}
+
+// ControllingBotProperty returns the property m_bControllingBot.
+func (e *CCSPlayerController) ControllingBotProperty() st.Property {
+	return e.controllingBot
+}
+
+// OriginalControllerOfCurrentPawn returns the value of the property m_hOriginalControllerOfCurrentPawn.
+func (e *CCSPlayerController) OriginalControllerOfCurrentPawn() uint64 {
+	return uint64Value(e.originalControllerOfCurrentPawn)
+}
+
+// OriginalControllerOfCurrentPawnProperty returns the property m_hOriginalControllerOfCurrentPawn.
+func (e *CCSPlayerController) OriginalControllerOfCurrentPawnProperty() st.Property {
+	return e.originalControllerOfCurrentPawn
+}
+
+// CompetitiveRankType returns the value of the property m_iCompetitiveRankType.
+func (e *CCSPlayerController) CompetitiveRankType() int {
+	return intValue(e.competitiveRankType)
+}
+
+// CompetitiveRankTypeProperty returns the property m_iCompetitiveRankType.
+func (e *CCSPlayerController) CompetitiveRankTypeProperty() st.Property {
+	return e.competitiveRankType
+}
+
+// CompetitiveRanking returns the value of the property m_iCompetitiveRanking.
+func (e *CCSPlayerController) CompetitiveRanking() int {
+	return intValue(e.competitiveRanking)
+}
+
+// CompetitiveRankingProperty returns the property m_iCompetitiveRanking.
+func (e *CCSPlayerController) CompetitiveRankingProperty() st.Property {
+	return e.competitiveRanking
+}
+
+// CompetitiveWins returns the value of the property m_iCompetitiveWins.
+func (e *CCSPlayerController) CompetitiveWins() int {
+	return intValue(e.competitiveWins)
+}
+
+// CompetitiveWinsProperty returns the property m_iCompetitiveWins.
+func (e *CCSPlayerController) CompetitiveWinsProperty() st.Property {
+	return e.competitiveWins
+}
+
+// Clan returns the value of the property m_szClan.
+func (e *CCSPlayerController) Clan() string {
+	return stringValue(e.clan)
+}
+
+// ClanProperty returns the property m_szClan.
+func (e *CCSPlayerController) ClanProperty() st.Property {
+	return e.clan
+}
+
+// CrosshairCodes returns the value of the property m_szCrosshairCodes.
+func (e *CCSPlayerController) CrosshairCodes() string {
+	return stringValue(e.crosshairCodes)
+}
+
+// CrosshairCodesProperty returns the property m_szCrosshairCodes.
+func (e *CCSPlayerController) CrosshairCodesProperty() st.Property {
+	return e.crosshairCodes
+}
+
+// Ping returns the value of the property m_iPing.
+func (e *CCSPlayerController) Ping() uint64 {
+	return uint64Value(e.ping)
+}
+
+// PingProperty returns the property m_iPing.
+func (e *CCSPlayerController) PingProperty() st.Property {
+	return e.ping
+}
+
+// Score returns the value of the property m_iScore.
+func (e *CCSPlayerController) Score() int {
+	return intValue(e.score)
+}
+
+// ScoreProperty returns the property m_iScore.
+func (e *CCSPlayerController) ScoreProperty() st.Property {
+	return e.score
+}
+
+// MVPs returns the value of the property m_iMVPs.
+func (e *CCSPlayerController) MVPs() int {
+	return intValue(e.mvPs)
+}
+
+// MVPsProperty returns the property m_iMVPs.
+func (e *CCSPlayerController) MVPsProperty() st.Property {
+	return e.mvPs
+}
+
+// CompTeammateColor returns the value of the property m_iCompTeammateColor.
+func (e *CCSPlayerController) CompTeammateColor() int {
+	return intValue(e.compTeammateColor)
+}
+
+// CompTeammateColorProperty returns the property m_iCompTeammateColor.
+func (e *CCSPlayerController) CompTeammateColorProperty() st.Property {
+	return e.compTeammateColor
+}
+
+// Account returns the value of the property m_pInGameMoneyServices.m_iAccount.
+func (e *CCSPlayerController) Account() int {
+	return intValue(e.account)
+}
+
+// AccountProperty returns the property m_pInGameMoneyServices.m_iAccount.
+func (e *CCSPlayerController) AccountProperty() st.Property {
+	return e.account
+}
+
+// TotalCashSpent returns the value of the property m_pInGameMoneyServices.m_iTotalCashSpent.
+func (e *CCSPlayerController) TotalCashSpent() int {
+	return intValue(e.totalCashSpent)
+}
+
+// TotalCashSpentProperty returns the property m_pInGameMoneyServices.m_iTotalCashSpent.
+func (e *CCSPlayerController) TotalCashSpentProperty() st.Property {
+	return e.totalCashSpent
+}
+
+// CashSpentThisRound returns the value of the property m_pInGameMoneyServices.m_iCashSpentThisRound.
+func (e *CCSPlayerController) CashSpentThisRound() int {
+	return intValue(e.cashSpentThisRound)
+}
+
+// CashSpentThisRoundProperty returns the property m_pInGameMoneyServices.m_iCashSpentThisRound.
+func (e *CCSPlayerController) CashSpentThisRoundProperty() st.Property {
+	return e.cashSpentThisRound
+}
+
+// Kills returns the value of the property m_pActionTrackingServices.m_iKills.
+func (e *CCSPlayerController) Kills() int {
+	return intValue(e.kills)
+}
+
+// KillsProperty returns the property m_pActionTrackingServices.m_iKills.
+func (e *CCSPlayerController) KillsProperty() st.Property {
+	return e.kills
+}
+
+// Deaths returns the value of the property m_pActionTrackingServices.m_iDeaths.
+func (e *CCSPlayerController) Deaths() int {
+	return intValue(e.deaths)
+}
+
+// DeathsProperty returns the property m_pActionTrackingServices.m_iDeaths.
+func (e *CCSPlayerController) DeathsProperty() st.Property {
+	return e.deaths
+}
+
+// Assists returns the value of the property m_pActionTrackingServices.m_iAssists.
+func (e *CCSPlayerController) Assists() int {
+	return intValue(e.assists)
+}
+
+// AssistsProperty returns the property m_pActionTrackingServices.m_iAssists.
+func (e *CCSPlayerController) AssistsProperty() st.Property {
+	return e.assists
+}
+
+// CCSPlayerPawn is a typed wrapper for entities of the server-class CCSPlayerPawn.
+type CCSPlayerPawn struct {
+	Entity st.Entity
+
+	controller                  st.Property
+	teamNum                     st.Property
+	health                      st.Property
+	armorValue                  st.Property
+	lifeState                   st.Property
+	flags                       st.Property
+	groundEntity                st.Property
+	flashDuration               st.Property
+	eyeAngles                   st.Property
+	isDefusing                  st.Property
+	isScoped                    st.Property
+	isWalking                   st.Property
+	inBombZone                  st.Property
+	inBuyZone                   st.Property
+	isGrabbingHostage           st.Property
+	whichBombZone               st.Property
+	lastPlaceName               st.Property
+	currentEquipmentValue       st.Property
+	roundStartEquipmentValue    st.Property
+	freezetimeEndEquipmentValue st.Property
+	viewmodelOffsetX            st.Property
+	viewmodelOffsetY            st.Property
+	viewmodelOffsetZ            st.Property
+	viewmodelFOV                st.Property
+	activeWeapon                st.Property
+	hasDefuser                  st.Property
+	hasHelmet                   st.Property
+	duckAmount                  st.Property
+	desiresDuck                 st.Property
+}
+
+// BindCCSPlayerPawn binds a CCSPlayerPawn wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCCSPlayerPawn(entity st.Entity) (*CCSPlayerPawn, error) {
+	b := newBinder(entity)
+
+	e := &CCSPlayerPawn{
+		Entity:                      entity,
+		controller:                  b.property("m_hController"),
+		teamNum:                     b.property("m_iTeamNum"),
+		health:                      b.property("m_iHealth"),
+		armorValue:                  b.property("m_ArmorValue"),
+		lifeState:                   b.property("m_lifeState"),
+		flags:                       b.property("m_fFlags"),
+		groundEntity:                b.property("m_hGroundEntity"),
+		flashDuration:               b.property("m_flFlashDuration"),
+		eyeAngles:                   b.property("m_angEyeAngles"),
+		isDefusing:                  b.property("m_bIsDefusing"),
+		isScoped:                    b.property("m_bIsScoped"),
+		isWalking:                   b.property("m_bIsWalking"),
+		inBombZone:                  b.property("m_bInBombZone"),
+		inBuyZone:                   b.property("m_bInBuyZone"),
+		isGrabbingHostage:           b.property("m_bIsGrabbingHostage"),
+		whichBombZone:               b.property("m_nWhichBombZone"),
+		lastPlaceName:               b.property("m_szLastPlaceName"),
+		currentEquipmentValue:       b.property("m_unCurrentEquipmentValue"),
+		roundStartEquipmentValue:    b.property("m_unRoundStartEquipmentValue"),
+		freezetimeEndEquipmentValue: b.property("m_unFreezetimeEndEquipmentValue"),
+		viewmodelOffsetX:            b.property("m_flViewmodelOffsetX"),
+		viewmodelOffsetY:            b.property("m_flViewmodelOffsetY"),
+		viewmodelOffsetZ:            b.property("m_flViewmodelOffsetZ"),
+		viewmodelFOV:                b.property("m_flViewmodelFOV"),
+		activeWeapon:                b.property("m_pWeaponServices.m_hActiveWeapon"),
+		hasDefuser:                  b.property("m_pItemServices.m_bHasDefuser"),
+		hasHelmet:                   b.property("m_pItemServices.m_bHasHelmet"),
+		duckAmount:                  b.property("m_pMovementServices.m_flDuckAmount"),
+		desiresDuck:                 b.property("m_pMovementServices.m_bDesiresDuck"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Controller returns the value of the property m_hController.
+func (e *CCSPlayerPawn) Controller() uint64 {
+	return uint64Value(e.controller)
+}
+
+// ControllerProperty returns the property m_hController.
+func (e *CCSPlayerPawn) ControllerProperty() st.Property {
+	return e.controller
+}
+
+// TeamNum returns the value of the property m_iTeamNum.
+func (e *CCSPlayerPawn) TeamNum() uint64 {
+	return uint64Value(e.teamNum)
+}
+
+// TeamNumProperty returns the property m_iTeamNum.
+func (e *CCSPlayerPawn) TeamNumProperty() st.Property {
+	return e.teamNum
+}
+
+// Health returns the value of the property m_iHealth.
+func (e *CCSPlayerPawn) Health() int {
+	return intValue(e.health)
+}
+
+// HealthProperty returns the property m_iHealth.
+func (e *CCSPlayerPawn) HealthProperty() st.Property {
+	return e.health
+}
+
+// ArmorValue returns the value of the property m_ArmorValue.
+func (e *CCSPlayerPawn) ArmorValue() int {
+	return intValue(e.armorValue)
+}
+
+// ArmorValueProperty returns the property m_ArmorValue.
+func (e *CCSPlayerPawn) ArmorValueProperty() st.Property {
+	return e.armorValue
+}
+
+// LifeState returns the value of the property m_lifeState.
+func (e *CCSPlayerPawn) LifeState() uint64 {
+	return uint64Value(e.lifeState)
+}
+
+// LifeStateProperty returns the property m_lifeState.
+func (e *CCSPlayerPawn) LifeStateProperty() st.Property {
+	return e.lifeState
+}
+
+// Flags returns the value of the property m_fFlags.
+func (e *CCSPlayerPawn) Flags() uint64 {
+	return uint64Value(e.flags)
+}
+
+// FlagsProperty returns the property m_fFlags.
+func (e *CCSPlayerPawn) FlagsProperty() st.Property {
+	return e.flags
+}
+
+// GroundEntity returns the value of the property m_hGroundEntity.
+func (e *CCSPlayerPawn) GroundEntity() uint64 {
+	return uint64Value(e.groundEntity)
+}
+
+// GroundEntityProperty returns the property m_hGroundEntity.
+func (e *CCSPlayerPawn) GroundEntityProperty() st.Property {
+	return e.groundEntity
+}
+
+// FlashDuration returns the value of the property m_flFlashDuration.
+func (e *CCSPlayerPawn) FlashDuration() float32 {
+	return float32Value(e.flashDuration)
+}
+
+// FlashDurationProperty returns the property m_flFlashDuration.
+func (e *CCSPlayerPawn) FlashDurationProperty() st.Property {
+	return e.flashDuration
+}
+
+// EyeAngles returns the value of the property m_angEyeAngles.
+func (e *CCSPlayerPawn) EyeAngles() r3.Vector {
+	return vectorValue(e.eyeAngles)
+}
+
+// EyeAnglesProperty returns the property m_angEyeAngles.
+func (e *CCSPlayerPawn) EyeAnglesProperty() st.Property {
+	return e.eyeAngles
+}
+
+// IsDefusing returns the value of the property m_bIsDefusing.
+func (e *CCSPlayerPawn) IsDefusing() bool {
+	return boolValue(e.isDefusing)
+}
+
+// IsDefusingProperty returns the property m_bIsDefusing.
+func (e *CCSPlayerPawn) IsDefusingProperty() st.Property {
+	return e.isDefusing
+}
+
+// IsScoped returns the value of the property m_bIsScoped.
+func (e *CCSPlayerPawn) IsScoped() bool {
+	return boolValue(e.isScoped)
+}
+
+// IsScopedProperty returns the property m_bIsScoped.
+func (e *CCSPlayerPawn) IsScopedProperty() st.Property {
+	return e.isScoped
+}
+
+// IsWalking returns the value of the property m_bIsWalking.
+func (e *CCSPlayerPawn) IsWalking() bool {
+	return boolValue(e.isWalking)
+}
+
+// IsWalkingProperty returns the property m_bIsWalking.
+func (e *CCSPlayerPawn) IsWalkingProperty() st.Property {
+	return e.isWalking
+}
+
+// InBombZone returns the value of the property m_bInBombZone.
+func (e *CCSPlayerPawn) InBombZone() bool {
+	return boolValue(e.inBombZone)
+}
+
+// InBombZoneProperty returns the property m_bInBombZone.
+func (e *CCSPlayerPawn) InBombZoneProperty() st.Property {
+	return e.inBombZone
+}
+
+// InBuyZone returns the value of the property m_bInBuyZone.
+func (e *CCSPlayerPawn) InBuyZone() bool {
+	return boolValue(e.inBuyZone)
+}
+
+// InBuyZoneProperty returns the property m_bInBuyZone.
+func (e *CCSPlayerPawn) InBuyZoneProperty() st.Property {
+	return e.inBuyZone
+}
+
+// IsGrabbingHostage returns the value of the property m_bIsGrabbingHostage.
+func (e *CCSPlayerPawn) IsGrabbingHostage() bool {
+	return boolValue(e.isGrabbingHostage)
+}
+
+// IsGrabbingHostageProperty returns the property m_bIsGrabbingHostage.
+func (e *CCSPlayerPawn) IsGrabbingHostageProperty() st.Property {
+	return e.isGrabbingHostage
+}
+
+// WhichBombZone returns the value of the property m_nWhichBombZone.
+func (e *CCSPlayerPawn) WhichBombZone() int {
+	return intValue(e.whichBombZone)
+}
+
+// WhichBombZoneProperty returns the property m_nWhichBombZone.
+func (e *CCSPlayerPawn) WhichBombZoneProperty() st.Property {
+	return e.whichBombZone
+}
+
+// LastPlaceName returns the value of the property m_szLastPlaceName.
+func (e *CCSPlayerPawn) LastPlaceName() string {
+	return stringValue(e.lastPlaceName)
+}
+
+// LastPlaceNameProperty returns the property m_szLastPlaceName.
+func (e *CCSPlayerPawn) LastPlaceNameProperty() st.Property {
+	return e.lastPlaceName
+}
+
+// CurrentEquipmentValue returns the value of the property m_unCurrentEquipmentValue.
+func (e *CCSPlayerPawn) CurrentEquipmentValue() uint64 {
+	return uint64Value(e.currentEquipmentValue)
+}
+
+// CurrentEquipmentValueProperty returns the property m_unCurrentEquipmentValue.
+func (e *CCSPlayerPawn) CurrentEquipmentValueProperty() st.Property {
+	return e.currentEquipmentValue
+}
+
+// RoundStartEquipmentValue returns the value of the property m_unRoundStartEquipmentValue.
+func (e *CCSPlayerPawn) RoundStartEquipmentValue() uint64 {
+	return uint64Value(e.roundStartEquipmentValue)
+}
+
+// RoundStartEquipmentValueProperty returns the property m_unRoundStartEquipmentValue.
+func (e *CCSPlayerPawn) RoundStartEquipmentValueProperty() st.Property {
+	return e.roundStartEquipmentValue
+}
+
+// FreezetimeEndEquipmentValue returns the value of the property m_unFreezetimeEndEquipmentValue.
+func (e *CCSPlayerPawn) FreezetimeEndEquipmentValue() uint64 {
+	return uint64Value(e.freezetimeEndEquipmentValue)
+}
+
+// FreezetimeEndEquipmentValueProperty returns the property m_unFreezetimeEndEquipmentValue.
+func (e *CCSPlayerPawn) FreezetimeEndEquipmentValueProperty() st.Property {
+	return e.freezetimeEndEquipmentValue
+}
+
+// ViewmodelOffsetX returns the value of the property m_flViewmodelOffsetX.
+func (e *CCSPlayerPawn) ViewmodelOffsetX() float32 {
+	return float32Value(e.viewmodelOffsetX)
+}
+
+// ViewmodelOffsetXProperty returns the property m_flViewmodelOffsetX.
+func (e *CCSPlayerPawn) ViewmodelOffsetXProperty() st.Property {
+	return e.viewmodelOffsetX
+}
+
+// ViewmodelOffsetY returns the value of the property m_flViewmodelOffsetY.
+func (e *CCSPlayerPawn) ViewmodelOffsetY() float32 {
+	return float32Value(e.viewmodelOffsetY)
+}
+
+// ViewmodelOffsetYProperty returns the property m_flViewmodelOffsetY.
+func (e *CCSPlayerPawn) ViewmodelOffsetYProperty() st.Property {
+	return e.viewmodelOffsetY
+}
+
+// ViewmodelOffsetZ returns the value of the property m_flViewmodelOffsetZ.
+func (e *CCSPlayerPawn) ViewmodelOffsetZ() float32 {
+	return float32Value(e.viewmodelOffsetZ)
+}
+
+// ViewmodelOffsetZProperty returns the property m_flViewmodelOffsetZ.
+func (e *CCSPlayerPawn) ViewmodelOffsetZProperty() st.Property {
+	return e.viewmodelOffsetZ
+}
+
+// ViewmodelFOV returns the value of the property m_flViewmodelFOV.
+func (e *CCSPlayerPawn) ViewmodelFOV() float32 {
+	return float32Value(e.viewmodelFOV)
+}
+
+// ViewmodelFOVProperty returns the property m_flViewmodelFOV.
+func (e *CCSPlayerPawn) ViewmodelFOVProperty() st.Property {
+	return e.viewmodelFOV
+}
+
+// ActiveWeapon returns the value of the property m_pWeaponServices.m_hActiveWeapon.
+func (e *CCSPlayerPawn) ActiveWeapon() uint64 {
+	return uint64Value(e.activeWeapon)
+}
+
+// ActiveWeaponProperty returns the property m_pWeaponServices.m_hActiveWeapon.
+func (e *CCSPlayerPawn) ActiveWeaponProperty() st.Property {
+	return e.activeWeapon
+}
+
+// HasDefuser returns the value of the property m_pItemServices.m_bHasDefuser.
+func (e *CCSPlayerPawn) HasDefuser() bool {
+	return boolValue(e.hasDefuser)
+}
+
+// HasDefuserProperty returns the property m_pItemServices.m_bHasDefuser.
+func (e *CCSPlayerPawn) HasDefuserProperty() st.Property {
+	return e.hasDefuser
+}
+
+// HasHelmet returns the value of the property m_pItemServices.m_bHasHelmet.
+func (e *CCSPlayerPawn) HasHelmet() bool {
+	return boolValue(e.hasHelmet)
+}
+
+// HasHelmetProperty returns the property m_pItemServices.m_bHasHelmet.
+func (e *CCSPlayerPawn) HasHelmetProperty() st.Property {
+	return e.hasHelmet
+}
+
+// DuckAmount returns the value of the property m_pMovementServices.m_flDuckAmount.
+func (e *CCSPlayerPawn) DuckAmount() float32 {
+	return float32Value(e.duckAmount)
+}
+
+// DuckAmountProperty returns the property m_pMovementServices.m_flDuckAmount.
+func (e *CCSPlayerPawn) DuckAmountProperty() st.Property {
+	return e.duckAmount
+}
+
+// DesiresDuck returns the value of the property m_pMovementServices.m_bDesiresDuck.
+func (e *CCSPlayerPawn) DesiresDuck() bool {
+	return boolValue(e.desiresDuck)
+}
+
+// DesiresDuckProperty returns the property m_pMovementServices.m_bDesiresDuck.
+func (e *CCSPlayerPawn) DesiresDuckProperty() st.Property {
+	return e.desiresDuck
+}
+
+// CCSPlayerResource is a typed wrapper for entities of the server-class CCSPlayerResource.
+type CCSPlayerResource struct {
+	Entity st.Entity
+
+	bombsiteCenterA st.Property
+	bombsiteCenterB st.Property
+}
+
+// BindCCSPlayerResource binds a CCSPlayerResource wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCCSPlayerResource(entity st.Entity) (*CCSPlayerResource, error) {
+	b := newBinder(entity)
+
+	e := &CCSPlayerResource{
+		Entity:          entity,
+		bombsiteCenterA: b.property("m_bombsiteCenterA"),
+		bombsiteCenterB: b.property("m_bombsiteCenterB"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// BombsiteCenterA returns the value of the property m_bombsiteCenterA.
+func (e *CCSPlayerResource) BombsiteCenterA() r3.Vector {
+	return vectorValue(e.bombsiteCenterA)
+}
+
+// BombsiteCenterAProperty returns the property m_bombsiteCenterA.
+func (e *CCSPlayerResource) BombsiteCenterAProperty() st.Property {
+	return e.bombsiteCenterA
+}
+
+// BombsiteCenterB returns the value of the property m_bombsiteCenterB.
+func (e *CCSPlayerResource) BombsiteCenterB() r3.Vector {
+	return vectorValue(e.bombsiteCenterB)
+}
+
+// BombsiteCenterBProperty returns the property m_bombsiteCenterB.
+func (e *CCSPlayerResource) BombsiteCenterBProperty() st.Property {
+	return e.bombsiteCenterB
+}
+
+// CCSTeam is a typed wrapper for entities of the server-class CCSTeam.
+type CCSTeam struct {
+	Entity st.Entity
+
+	teamNum       st.Property
+	teamname      st.Property
+	clanTeamname  st.Property
+	teamFlagImage st.Property
+	score         st.Property
+}
+
+// BindCCSTeam binds a CCSTeam wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCCSTeam(entity st.Entity) (*CCSTeam, error) {
+	b := newBinder(entity)
+
+	e := &CCSTeam{
+		Entity:        entity,
+		teamNum:       b.property("m_iTeamNum"),
+		teamname:      b.property("m_szTeamname"),
+		clanTeamname:  b.property("m_szClanTeamname"),
+		teamFlagImage: b.property("m_szTeamFlagImage"),
+		score:         b.property("m_iScore"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// TeamNum returns the value of the property m_iTeamNum.
+func (e *CCSTeam) TeamNum() uint64 {
+	return uint64Value(e.teamNum)
+}
+
+// TeamNumProperty returns the property m_iTeamNum.
+func (e *CCSTeam) TeamNumProperty() st.Property {
+	return e.teamNum
+}
+
+// Teamname returns the value of the property m_szTeamname.
+func (e *CCSTeam) Teamname() string {
+	return stringValue(e.teamname)
+}
+
+// TeamnameProperty returns the property m_szTeamname.
+func (e *CCSTeam) TeamnameProperty() st.Property {
+	return e.teamname
+}
+
+// ClanTeamname returns the value of the property m_szClanTeamname.
+func (e *CCSTeam) ClanTeamname() string {
+	return stringValue(e.clanTeamname)
+}
+
+// ClanTeamnameProperty returns the property m_szClanTeamname.
+func (e *CCSTeam) ClanTeamnameProperty() st.Property {
+	return e.clanTeamname
+}
+
+// TeamFlagImage returns the value of the property m_szTeamFlagImage.
+func (e *CCSTeam) TeamFlagImage() string {
+	return stringValue(e.teamFlagImage)
+}
+
+// TeamFlagImageProperty returns the property m_szTeamFlagImage.
+func (e *CCSTeam) TeamFlagImageProperty() st.Property {
+	return e.teamFlagImage
+}
+
+// Score returns the value of the property m_iScore.
+func (e *CCSTeam) Score() int {
+	return intValue(e.score)
+}
+
+// ScoreProperty returns the property m_iScore.
+func (e *CCSTeam) ScoreProperty() st.Property {
+	return e.score
+}
+
+// CHostage is a typed wrapper for entities of the server-class CHostage.
+type CHostage struct {
+	Entity st.Entity
+
+	hostageState   st.Property
+	health         st.Property
+	leader         st.Property
+	hostageGrabber st.Property
+}
+
+// BindCHostage binds a CHostage wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCHostage(entity st.Entity) (*CHostage, error) {
+	b := newBinder(entity)
+
+	e := &CHostage{
+		Entity:         entity,
+		hostageState:   b.property("m_nHostageState"),
+		health:         b.property("m_iHealth"),
+		leader:         b.property("m_leader"),
+		hostageGrabber: b.property("m_hHostageGrabber"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// HostageState returns the value of the property m_nHostageState.
+func (e *CHostage) HostageState() int {
+	return intValue(e.hostageState)
+}
+
+// HostageStateProperty returns the property m_nHostageState.
+func (e *CHostage) HostageStateProperty() st.Property {
+	return e.hostageState
+}
+
+// Health returns the value of the property m_iHealth.
+func (e *CHostage) Health() int {
+	return intValue(e.health)
+}
+
+// HealthProperty returns the property m_iHealth.
+func (e *CHostage) HealthProperty() st.Property {
+	return e.health
+}
+
+// Leader returns the value of the property m_leader.
+func (e *CHostage) Leader() uint64 {
+	return uint64Value(e.leader)
+}
+
+// LeaderProperty returns the property m_leader.
+func (e *CHostage) LeaderProperty() st.Property {
+	return e.leader
+}
+
+// HostageGrabber returns the value of the property m_hHostageGrabber.
+func (e *CHostage) HostageGrabber() uint64 {
+	return uint64Value(e.hostageGrabber)
+}
+
+// HostageGrabberProperty returns the property m_hHostageGrabber.
+func (e *CHostage) HostageGrabberProperty() st.Property {
+	return e.hostageGrabber
+}
+
+// CInferno is a typed wrapper for entities of the server-class CInferno.
+type CInferno struct {
+	Entity st.Entity
+
+	ownerEntity st.Property
+	fireCount   st.Property
+}
+
+// BindCInferno binds a CInferno wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCInferno(entity st.Entity) (*CInferno, error) {
+	b := newBinder(entity)
+
+	e := &CInferno{
+		Entity:      entity,
+		ownerEntity: b.property("m_hOwnerEntity"),
+		fireCount:   b.property("m_fireCount"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// OwnerEntity returns the value of the property m_hOwnerEntity.
+func (e *CInferno) OwnerEntity() uint64 {
+	return uint64Value(e.ownerEntity)
+}
+
+// OwnerEntityProperty returns the property m_hOwnerEntity.
+func (e *CInferno) OwnerEntityProperty() st.Property {
+	return e.ownerEntity
+}
+
+// FireCount returns the value of the property m_fireCount.
+func (e *CInferno) FireCount() int {
+	return intValue(e.fireCount)
+}
+
+// FireCountProperty returns the property m_fireCount.
+func (e *CInferno) FireCountProperty() st.Property {
+	return e.fireCount
+}
+
+// CPlantedC4 is a typed wrapper for entities of the server-class CPlantedC4.
+type CPlantedC4 struct {
+	Entity st.Entity
+
+	ownerEntity st.Property
+	bombSite    st.Property
+	bombTicking st.Property
+	bombDefuser st.Property
+	bombDefused st.Property
+}
+
+// BindCPlantedC4 binds a CPlantedC4 wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindCPlantedC4(entity st.Entity) (*CPlantedC4, error) {
+	b := newBinder(entity)
+
+	e := &CPlantedC4{
+		Entity:      entity,
+		ownerEntity: b.property("m_hOwnerEntity"),
+		bombSite:    b.property("m_nBombSite"),
+		bombTicking: b.property("m_bBombTicking"),
+		bombDefuser: b.property("m_hBombDefuser"),
+		bombDefused: b.property("m_bBombDefused"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// OwnerEntity returns the value of the property m_hOwnerEntity.
+func (e *CPlantedC4) OwnerEntity() uint64 {
+	return uint64Value(e.ownerEntity)
+}
+
+// OwnerEntityProperty returns the property m_hOwnerEntity.
+func (e *CPlantedC4) OwnerEntityProperty() st.Property {
+	return e.ownerEntity
+}
+
+// BombSite returns the value of the property m_nBombSite.
+func (e *CPlantedC4) BombSite() int {
+	return intValue(e.bombSite)
+}
+
+// BombSiteProperty returns the property m_nBombSite.
+func (e *CPlantedC4) BombSiteProperty() st.Property {
+	return e.bombSite
+}
+
+// BombTicking returns the value of the property m_bBombTicking.
+func (e *CPlantedC4) BombTicking() bool {
+	return boolValue(e.bombTicking)
+}
+
+// BombTickingProperty returns the property m_bBombTicking.
+func (e *CPlantedC4) BombTickingProperty() st.Property {
+	return e.bombTicking
+}
+
+// BombDefuser returns the value of the property m_hBombDefuser.
+func (e *CPlantedC4) BombDefuser() uint64 {
+	return uint64Value(e.bombDefuser)
+}
+
+// BombDefuserProperty returns the property m_hBombDefuser.
+func (e *CPlantedC4) BombDefuserProperty() st.Property {
+	return e.bombDefuser
+}
+
+// BombDefused returns the value of the property m_bBombDefused.
+func (e *CPlantedC4) BombDefused() bool {
+	return boolValue(e.bombDefused)
+}
+
+// BombDefusedProperty returns the property m_bBombDefused.
+func (e *CPlantedC4) BombDefusedProperty() st.Property {
+	return e.bombDefused
+}
+
+// GrenadeProjectile is a typed wrapper for entities of all server-classes with the property m_hThrower.
+type GrenadeProjectile struct {
+	Entity st.Entity
+
+	thrower     st.Property
+	ownerEntity st.Property
+	model       st.Property
+}
+
+// BindGrenadeProjectile binds a GrenadeProjectile wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindGrenadeProjectile(entity st.Entity) (*GrenadeProjectile, error) {
+	b := newBinder(entity)
+
+	e := &GrenadeProjectile{
+		Entity:      entity,
+		thrower:     b.property("m_hThrower"),
+		ownerEntity: b.property("m_hOwnerEntity"),
+		model:       b.property("CBodyComponent.m_hModel"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Thrower returns the value of the property m_hThrower.
+func (e *GrenadeProjectile) Thrower() uint64 {
+	return uint64Value(e.thrower)
+}
+
+// ThrowerProperty returns the property m_hThrower.
+func (e *GrenadeProjectile) ThrowerProperty() st.Property {
+	return e.thrower
+}
+
+// OwnerEntity returns the value of the property m_hOwnerEntity.
+func (e *GrenadeProjectile) OwnerEntity() uint64 {
+	return uint64Value(e.ownerEntity)
+}
+
+// OwnerEntityProperty returns the property m_hOwnerEntity.
+func (e *GrenadeProjectile) OwnerEntityProperty() st.Property {
+	return e.ownerEntity
+}
+
+// Model returns the value of the property CBodyComponent.m_hModel.
+func (e *GrenadeProjectile) Model() uint64 {
+	return uint64Value(e.model)
+}
+
+// ModelProperty returns the property CBodyComponent.m_hModel.
+func (e *GrenadeProjectile) ModelProperty() st.Property {
+	return e.model
+}
+
+// Weapon is a typed wrapper for entities of all server-classes with the properties m_iItemDefinitionIndex and m_iClip1.
+type Weapon struct {
+	Entity st.Entity
+
+	itemDefinitionIndex st.Property
+	clip1               st.Property
+	ownerEntity         st.Property
+	model               st.Property
+}
+
+// BindWeapon binds a Weapon wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func BindWeapon(entity st.Entity) (*Weapon, error) {
+	b := newBinder(entity)
+
+	e := &Weapon{
+		Entity:              entity,
+		itemDefinitionIndex: b.property("m_iItemDefinitionIndex"),
+		clip1:               b.property("m_iClip1"),
+		ownerEntity:         b.property("m_hOwnerEntity"),
+		model:               b.property("CBodyComponent.m_hModel"),
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// ItemDefinitionIndex returns the value of the property m_iItemDefinitionIndex.
+func (e *Weapon) ItemDefinitionIndex() uint64 {
+	return uint64Value(e.itemDefinitionIndex)
+}
+
+// ItemDefinitionIndexProperty returns the property m_iItemDefinitionIndex.
+func (e *Weapon) ItemDefinitionIndexProperty() st.Property {
+	return e.itemDefinitionIndex
+}
+
+// Clip1 returns the value of the property m_iClip1.
+func (e *Weapon) Clip1() uint32 {
+	return uint32Value(e.clip1)
+}
+
+// Clip1Property returns the property m_iClip1.
+func (e *Weapon) Clip1Property() st.Property {
+	return e.clip1
+}
+
+// OwnerEntity returns the value of the property m_hOwnerEntity.
+func (e *Weapon) OwnerEntity() uint64 {
+	return uint64Value(e.ownerEntity)
+}
+
+// OwnerEntityProperty returns the property m_hOwnerEntity.
+func (e *Weapon) OwnerEntityProperty() st.Property {
+	return e.ownerEntity
+}
+
+// Model returns the value of the property CBodyComponent.m_hModel.
+func (e *Weapon) Model() uint64 {
+	return uint64Value(e.model)
+}
+
+// ModelProperty returns the property CBodyComponent.m_hModel.
+func (e *Weapon) ModelProperty() st.Property {
+	return e.model
+}
@@ -0,0 +1,95 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
+)
+
+type fakeServerClass struct {
+	st.ServerClass
+	name string
+}
+
+func (sc fakeServerClass) Name() string {
+	return sc.name
+}
+
+func entityWithProperties(className string, values map[string]any, missing ...string) *stfake.Entity {
+	entity := new(stfake.Entity)
+	entity.On("ServerClass").Return(fakeServerClass{name: className})
+
+	for name, val := range values {
+		prop := new(stfake.Property)
+		prop.On("Value").Return(st.PropertyValue{Any: val})
+		entity.On("Property", name).Return(prop)
+	}
+
+	for _, name := range missing {
+		entity.On("Property", name).Return(nil)
+	}
+
+	return entity
+}
+
+func TestBindCBombTarget(t *testing.T) {
+	entity := entityWithProperties("CBombTarget", map[string]any{
+		"m_vecMins": []float32{1, 2, 3},
+		"m_vecMaxs": []float32{4, 5, 6},
+	})
+
+	target, err := BindCBombTarget(entity)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity, target.Entity)
+	assert.Equal(t, r3.Vector{X: 1, Y: 2, Z: 3}, target.Mins())
+	assert.Equal(t, r3.Vector{X: 4, Y: 5, Z: 6}, target.Maxs())
+}
+
+func TestBindCInferno_ZeroValues(t *testing.T) {
+	entity := entityWithProperties("CInferno", map[string]any{
+		"m_hOwnerEntity": nil,
+		"m_fireCount":    int32(3),
+	})
+
+	inferno, err := BindCInferno(entity)
+
+	assert.NoError(t, err)
+	assert.Zero(t, inferno.OwnerEntity())
+	assert.Equal(t, 3, inferno.FireCount())
+}
+
+func TestBindCHostage_MissingProperties(t *testing.T) {
+	entity := entityWithProperties("CHostage", map[string]any{
+		"m_nHostageState": int32(1),
+		"m_iHealth":       int32(100),
+	}, "m_leader", "m_hHostageGrabber")
+
+	hostage, err := BindCHostage(entity)
+
+	assert.Nil(t, hostage)
+	assert.ErrorIs(t, err, ErrMissingProperty)
+	assert.ErrorContains(t, err, "CHostage.m_leader")
+	assert.ErrorContains(t, err, "CHostage.m_hHostageGrabber")
+}
+
+func TestBindWeapon(t *testing.T) {
+	entity := entityWithProperties("CAK47", map[string]any{
+		"m_iItemDefinitionIndex":  uint64(7),
+		"m_iClip1":                uint32(30),
+		"m_hOwnerEntity":          uint64(123),
+		"CBodyComponent.m_hModel": uint64(456),
+	})
+
+	weapon, err := BindWeapon(entity)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), weapon.ItemDefinitionIndex())
+	assert.Equal(t, uint32(30), weapon.Clip1())
+	assert.Equal(t, uint64(123), weapon.OwnerEntity())
+	assert.Equal(t, uint64(456), weapon.Model())
+}
@@ -0,0 +1,663 @@
+// Command gen generates typed entity wrappers (see package entities) from a class spec file.
+//
+// Usage:
+//
+//	go run ./gen -spec classes.txt -o entities_gen.go [-schema schema.json | -demo demo.dem] [-dump-schema schema.json]
+//
+// If a schema is given (either as JSON dump, see sendtables.Schema.WriteJSON(), or read from a demo),
+// all properties are checked against it and kinds that aren't specified in the spec are inferred.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+func main() {
+	specPath := flag.String("spec", "classes.txt", "Class spec file")
+	outPath := flag.String("o", "entities_gen.go", "Output file")
+	pkg := flag.String("pkg", "entities", "Package name of the generated code")
+	schemaPath := flag.String("schema", "", "Schema JSON dump to check properties against (optional)")
+	demoPath := flag.String("demo", "", "Demo to read the schema from (optional, alternative to -schema)")
+	dumpSchemaPath := flag.String("dump-schema", "", "Write the schema read from -demo to this file (optional)")
+	flag.Parse()
+
+	err := run(*specPath, *outPath, *pkg, *schemaPath, *demoPath, *dumpSchemaPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, outPath, pkg, schemaPath, demoPath, dumpSchemaPath string) error {
+	specFile, err := os.Open(specPath)
+	if err != nil {
+		return err
+	}
+
+	defer specFile.Close()
+
+	classes, err := parseSpec(specFile)
+	if err != nil {
+		return err
+	}
+
+	schema, err := loadSchema(schemaPath, demoPath)
+	if err != nil {
+		return err
+	}
+
+	if dumpSchemaPath != "" && schema != nil {
+		err = writeSchema(dumpSchemaPath, *schema)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = resolve(classes, schema)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(pkg, classes)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outPath, src, 0o644) //nolint:gosec
+}
+
+func loadSchema(schemaPath, demoPath string) (*st.Schema, error) {
+	switch {
+	case schemaPath != "":
+		f, err := os.Open(schemaPath)
+		if err != nil {
+			return nil, err
+		}
+
+		defer f.Close()
+
+		schema, err := st.ReadSchemaJSON(f)
+		if err != nil {
+			return nil, err
+		}
+
+		return &schema, nil
+
+	case demoPath != "":
+		return readSchemaFromDemo(demoPath)
+	}
+
+	return nil, nil
+}
+
+func readSchemaFromDemo(demoPath string) (*st.Schema, error) {
+	f, err := os.Open(demoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	p := demoinfocs.NewParser(f)
+	defer p.Close()
+
+	var schema *st.Schema
+
+	p.RegisterEventHandler(func(events.DataTablesParsed) {
+		s := p.ServerClasses().Schema()
+		schema = &s
+
+		p.Cancel()
+	})
+
+	err = p.ParseToEnd()
+	if err != nil && !errors.Is(err, demoinfocs.ErrCancelled) {
+		return nil, err
+	}
+
+	if schema == nil {
+		return nil, fmt.Errorf("no server-classes found in %q", demoPath)
+	}
+
+	return schema, nil
+}
+
+func writeSchema(path string, schema st.Schema) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = schema.WriteJSON(f)
+	if err != nil {
+		f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
+
+type kind string
+
+// possible kinds of properties, see kinds for the corresponding Go types
+const (
+	kindUnknown kind = ""
+	kindInt     kind = "int"
+	kindUInt32  kind = "uint32"
+	kindUInt64  kind = "uint64"
+	kindHandle  kind = "handle"
+	kindFloat32 kind = "float32"
+	kindBool    kind = "bool"
+	kindString  kind = "string"
+	kindVector  kind = "vector"
+)
+
+type kindInfo struct {
+	goType string
+	getter string // value getter in package entities
+}
+
+var kinds = map[kind]kindInfo{
+	kindInt:     {"int", "intValue"},
+	kindUInt32:  {"uint32", "uint32Value"},
+	kindUInt64:  {"uint64", "uint64Value"},
+	kindHandle:  {"uint64", "uint64Value"},
+	kindFloat32: {"float32", "float32Value"},
+	kindBool:    {"bool", "boolValue"},
+	kindString:  {"string", "stringValue"},
+	kindVector:  {"r3.Vector", "vectorValue"},
+}
+
+type propertySpec struct {
+	Name   string
+	Kind   kind
+	Method string
+	Field  string
+	line   int
+}
+
+func (p propertySpec) GoType() string {
+	return kinds[p.Kind].goType
+}
+
+func (p propertySpec) Getter() string {
+	return kinds[p.Kind].getter
+}
+
+type classSpec struct {
+	Name       string
+	Properties []*propertySpec
+	Selector   []string // if not empty, the wrapper is for all server-classes with these properties instead of the class Name
+	all        bool
+	line       int
+}
+
+// Description returns which entities the wrapper is for.
+func (c classSpec) Description() string {
+	if len(c.Selector) == 0 {
+		return "entities of the server-class " + c.Name
+	}
+
+	if len(c.Selector) == 1 {
+		return "entities of all server-classes with the property " + c.Selector[0]
+	}
+
+	return "entities of all server-classes with the properties " +
+		strings.Join(c.Selector[:len(c.Selector)-1], ", ") + " and " + c.Selector[len(c.Selector)-1]
+}
+
+// parseSpec parses a class spec file, see classes.txt for the format.
+func parseSpec(r io.Reader) ([]*classSpec, error) {
+	var (
+		classes []*classSpec
+		current *classSpec
+		lineNo  int
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if !unicode.IsSpace(rune(line[0])) {
+			fields := strings.Fields(trimmed)
+			current = &classSpec{Name: fields[0], line: lineNo}
+
+			if len(fields) > 1 {
+				if fields[1] != "with" || len(fields) < 3 {
+					return nil, fmt.Errorf("line %d: expected \"<class>\" or \"<name> with <property>...\"", lineNo)
+				}
+
+				current.Selector = fields[2:]
+			}
+
+			classes = append(classes, current)
+
+			continue
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("line %d: property without class", lineNo)
+		}
+
+		fields := strings.Fields(trimmed)
+		if fields[0] == "*" {
+			current.all = true
+
+			continue
+		}
+
+		if len(fields) > 3 {
+			return nil, fmt.Errorf("line %d: expected \"<property> [kind] [method name]\"", lineNo)
+		}
+
+		prop := &propertySpec{Name: fields[0], line: lineNo}
+
+		if len(fields) > 1 && fields[1] != "-" {
+			prop.Kind = kind(fields[1])
+
+			if _, ok := kinds[prop.Kind]; !ok {
+				return nil, fmt.Errorf("line %d: unknown kind %q", lineNo, fields[1])
+			}
+		}
+
+		if len(fields) > 2 {
+			prop.Method = fields[2]
+		}
+
+		current.Properties = append(current.Properties, prop)
+	}
+
+	return classes, scanner.Err()
+}
+
+// resolve checks the properties against the schema (if any), infers missing kinds and sets method & field names.
+func resolve(classes []*classSpec, schema *st.Schema) error {
+	var errs []error
+
+	for _, c := range classes {
+		errs = append(errs, resolveClass(c, schema)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func resolveClass(c *classSpec, schema *st.Schema) (errs []error) {
+	var classSchemas []*st.ClassSchema
+
+	if schema != nil {
+		classSchemas = matchingClasses(c, *schema)
+		if len(classSchemas) == 0 {
+			if len(c.Selector) > 0 {
+				return []error{fmt.Errorf("line %d: no class with properties %s found in schema", c.line, strings.Join(c.Selector, ", "))}
+			}
+
+			return []error{fmt.Errorf("class %s: not found in schema", c.Name)}
+		}
+	}
+
+	if c.all {
+		if classSchemas == nil || len(c.Selector) > 0 {
+			return []error{fmt.Errorf("class %s: '*' requires a schema and can't be combined with 'with'", c.Name)}
+		}
+
+		c.Properties = append(c.Properties, allProperties(c, *classSchemas[0])...)
+	}
+
+	for _, p := range c.Properties {
+		for _, classSchema := range classSchemas {
+			f := classSchema.Field(p.Name)
+			if f == nil {
+				errs = append(errs, fmt.Errorf("line %d: property %s.%s not found in schema", p.line, classSchema.Name, p.Name))
+
+				continue
+			}
+
+			inferred := inferKind(*f)
+
+			if p.Kind == kindUnknown {
+				p.Kind = inferred
+			} else if inferred != kindUnknown && !compatible(p.Kind, inferred) {
+				errs = append(errs, fmt.Errorf("line %d: property %s.%s is of kind %s (%s) but %s was specified", p.line, classSchema.Name, p.Name, inferred, f.VarType, p.Kind))
+			}
+		}
+
+		if p.Kind == kindUnknown {
+			errs = append(errs, fmt.Errorf("line %d: kind of property %s.%s not specified and can't be inferred", p.line, c.Name, p.Name))
+		}
+	}
+
+	setNames(c.Properties)
+
+	methods := make(map[string]bool)
+
+	for _, p := range c.Properties {
+		if methods[p.Method] || p.Method == "Entity" {
+			errs = append(errs, fmt.Errorf("class %s: duplicate method %s, specify a method name for property %s", c.Name, p.Method, p.Name))
+		}
+
+		methods[p.Method] = true
+	}
+
+	return errs
+}
+
+// matchingClasses returns the schema of the class with the spec's name,
+// or of all classes with the spec's selector properties (see classSpec.Selector).
+func matchingClasses(c *classSpec, schema st.Schema) []*st.ClassSchema {
+	if len(c.Selector) == 0 {
+		if classSchema := schema.Class(c.Name); classSchema != nil {
+			return []*st.ClassSchema{classSchema}
+		}
+
+		return nil
+	}
+
+	var matches []*st.ClassSchema
+
+	for i := range schema.Classes {
+		classSchema := &schema.Classes[i]
+		hasAll := true
+
+		for _, name := range c.Selector {
+			hasAll = hasAll && classSchema.Field(name) != nil
+		}
+
+		if hasAll {
+			matches = append(matches, classSchema)
+		}
+	}
+
+	return matches
+}
+
+// allProperties returns all simple properties of the class with an inferable kind that aren't already in the spec.
+func allProperties(c *classSpec, classSchema st.ClassSchema) []*propertySpec {
+	existing := make(map[string]bool)
+
+	for _, p := range c.Properties {
+		existing[p.Name] = true
+	}
+
+	var props []*propertySpec
+
+	for _, f := range classSchema.Fields {
+		if existing[f.Name] || f.Model != "simple" || strings.Contains(f.Name, "*") || inferKind(f) == kindUnknown {
+			continue
+		}
+
+		props = append(props, &propertySpec{Name: f.Name})
+	}
+
+	return props
+}
+
+func compatible(a, b kind) bool {
+	if a == kindHandle && b == kindUInt64 || a == kindUInt64 && b == kindHandle {
+		return true
+	}
+
+	return a == b
+}
+
+// inferKind returns the kind of the values the decoder for the field returns (see sendtablescs2/field_decoder.go).
+func inferKind(f st.FieldSchema) kind {
+	if f.Model != "simple" && f.Model != "fixed-array" {
+		return kindUnknown
+	}
+
+	baseType := f.VarType
+	isArray := false
+
+	if i := strings.IndexAny(baseType, "<["); i != -1 {
+		isArray = baseType[i] == '['
+		baseType = strings.TrimSpace(baseType[:i])
+	}
+
+	if baseType == "char" {
+		return kindString
+	}
+
+	// see fieldNameDecoders in sendtablescs2/field_decoder.go
+	if lastSegment(f.Name) == "m_iClip1" {
+		return kindUInt32
+	}
+
+	if isArray {
+		return kindUnknown
+	}
+
+	switch baseType {
+	case "bool":
+		return kindBool
+
+	case "int8", "int16", "int32", "HSequence", "CEntityIndex":
+		return kindInt
+
+	case "uint8", "uint16", "uint32", "uint64", "Color", "CUtlStringToken", "AttachmentHandle_t":
+		return kindUInt64
+
+	case "CHandle", "EHandle", "CEntityHandle", "CGameSceneNodeHandle", "CStrongHandle":
+		return kindHandle
+
+	case "float32", "GameTime_t", "CNetworkedQuantizedFloat":
+		return kindFloat32
+
+	case "Vector", "VectorWS", "QAngle":
+		if f.Encoder == "normal" {
+			return kindUnknown
+		}
+
+		return kindVector
+
+	case "CUtlString", "CUtlSymbolLarge":
+		return kindString
+	}
+
+	return kindUnknown
+}
+
+// setNames sets the method and field names of the properties.
+// Method names are derived from the last part of the property name unless they're ambiguous.
+func setNames(props []*propertySpec) {
+	count := make(map[string]int)
+
+	for _, p := range props {
+		if p.Method == "" {
+			count[methodName(lastSegment(p.Name))]++
+		}
+	}
+
+	for _, p := range props {
+		if p.Method == "" {
+			p.Method = methodName(lastSegment(p.Name))
+
+			if count[p.Method] > 1 {
+				p.Method = qualifiedMethodName(p.Name)
+			}
+		}
+
+		p.Field = fieldName(p.Method)
+	}
+}
+
+func lastSegment(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// hungarianPrefixes are stripped from property names, longest first.
+var hungarianPrefixes = []string{"isz", "vec", "ang", "clr", "fl", "sz", "un", "b", "i", "n", "h", "u", "e", "f", "p"}
+
+// methodName derives a method name from a property name, e.g. "m_flFlashDuration" -> "FlashDuration".
+func methodName(propName string) string {
+	s := strings.TrimPrefix(propName, "m_")
+
+	for _, prefix := range hungarianPrefixes {
+		if rest, ok := strings.CutPrefix(s, prefix); ok && rest != "" && unicode.IsUpper(rune(rest[0])) {
+			s = rest
+
+			break
+		}
+	}
+
+	var b strings.Builder
+
+	upperNext := true
+
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperNext = true
+
+			continue
+		}
+
+		if upperNext {
+			r = unicode.ToUpper(r)
+			upperNext = false
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// qualifiedMethodName derives a method name from all parts of a property name,
+// e.g. "m_pWeaponServices.m_hActiveWeapon" -> "WeaponServicesActiveWeapon".
+func qualifiedMethodName(propName string) string {
+	var b strings.Builder
+
+	for _, segment := range strings.Split(propName, ".") {
+		b.WriteString(methodName(segment))
+	}
+
+	return b.String()
+}
+
+// fieldName converts an exported method name to an unexported field name, e.g. "CTTimeOutActive" -> "ctTimeOutActive".
+func fieldName(method string) string {
+	runes := []rune(method)
+
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+var tmpl = template.Must(template.New("entities").Parse(`// Code generated by gen/main.go from classes.txt. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{- if .UsesVector }}
+	"github.com/golang/geo/r3"
+
+{{ end }}
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+{{ range .Classes }}
+{{- $class := . }}
+// {{ .Name }} is a typed wrapper for {{ .Description }}.
+type {{ .Name }} struct {
+	Entity st.Entity
+{{ range .Properties }}
+	{{ .Field }} st.Property
+{{- end }}
+}
+
+// Bind{{ .Name }} binds a {{ .Name }} wrapper to an entity.
+// Returns an error wrapping ErrMissingProperty if the entity doesn't have all properties.
+func Bind{{ .Name }}(entity st.Entity) (*{{ .Name }}, error) {
+	b := newBinder(entity)
+
+	e := &{{ .Name }}{
+		Entity: entity,
+{{- range .Properties }}
+		{{ .Field }}: b.property("{{ .Name }}"),
+{{- end }}
+	}
+
+	if err := b.err(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+{{ range .Properties }}
+// {{ .Method }} returns the value of the property {{ .Name }}.
+func (e *{{ $class.Name }}) {{ .Method }}() {{ .GoType }} {
+	return {{ .Getter }}(e.{{ .Field }})
+}
+
+// {{ .Method }}Property returns the property {{ .Name }}.
+func (e *{{ $class.Name }}) {{ .Method }}Property() st.Property {
+	return e.{{ .Field }}
+}
+{{ end }}
+{{- end }}`))
+
+func generate(pkg string, classes []*classSpec) ([]byte, error) {
+	sorted := make([]*classSpec, len(classes))
+	copy(sorted, classes)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	usesVector := false
+
+	for _, c := range classes {
+		for _, p := range c.Properties {
+			usesVector = usesVector || p.Kind == kindVector
+		}
+	}
+
+	var buf bytes.Buffer
+
+	err := tmpl.Execute(&buf, struct {
+		Package    string
+		Classes    []*classSpec
+		UsesVector bool
+	}{pkg, sorted, usesVector})
+	if err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w\n%s", err, buf.String())
+	}
+
+	return src, nil
+}
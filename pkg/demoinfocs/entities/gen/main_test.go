@@ -0,0 +1,154 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+func TestMethodName(t *testing.T) {
+	assert.Equal(t, "Health", methodName("m_iHealth"))
+	assert.Equal(t, "FlashDuration", methodName("m_flFlashDuration"))
+	assert.Equal(t, "PlayerName", methodName("m_iszPlayerName"))
+	assert.Equal(t, "ArmorValue", methodName("m_ArmorValue"))
+	assert.Equal(t, "TotalRoundsPlayed", methodName("m_totalRoundsPlayed"))
+	assert.Equal(t, "BombsiteCenterA", methodName("m_bombsiteCenterA"))
+	assert.Equal(t, "CellX", methodName("m_cellX"))
+	assert.Equal(t, "WeaponServicesActiveWeapon", qualifiedMethodName("m_pWeaponServices.m_hActiveWeapon"))
+}
+
+func TestFieldName(t *testing.T) {
+	assert.Equal(t, "health", fieldName("Health"))
+	assert.Equal(t, "ctTimeOuts", fieldName("CTTimeOuts"))
+	assert.Equal(t, "mvps", fieldName("MVPS"))
+}
+
+func TestInferKind(t *testing.T) {
+	assert.Equal(t, kindInt, inferKind(st.FieldSchema{VarType: "int32", Model: "simple"}))
+	assert.Equal(t, kindHandle, inferKind(st.FieldSchema{VarType: "CHandle< CBasePlayerWeapon >", Model: "simple"}))
+	assert.Equal(t, kindString, inferKind(st.FieldSchema{VarType: "char[129]", Model: "simple"}))
+	assert.Equal(t, kindVector, inferKind(st.FieldSchema{VarType: "QAngle", Model: "simple"}))
+	assert.Equal(t, kindUnknown, inferKind(st.FieldSchema{VarType: "bool[64]", Model: "fixed-array"}))
+	assert.Equal(t, kindUnknown, inferKind(st.FieldSchema{VarType: "Vector", Encoder: "normal", Model: "simple"}))
+}
+
+func TestResolve(t *testing.T) {
+	classes, err := parseSpec(strings.NewReader(`
+# comment
+CCSPlayerPawn
+	m_iHealth
+	m_flFlashDuration int
+	m_pWeaponServices.m_hActiveWeapon handle Weapon
+	m_iMissing int
+`))
+	assert.NoError(t, err)
+
+	schema := st.Schema{Classes: []st.ClassSchema{{Name: "CCSPlayerPawn", Fields: []st.FieldSchema{
+		{Name: "m_iHealth", VarType: "int32", Model: "simple"},
+		{Name: "m_flFlashDuration", VarType: "float32", Model: "simple"},
+		{Name: "m_pWeaponServices.m_hActiveWeapon", VarType: "CHandle< CBasePlayerWeapon >", Model: "simple"},
+	}}}}
+
+	err = resolve(classes, &schema)
+
+	assert.ErrorContains(t, err, "line 5: property CCSPlayerPawn.m_flFlashDuration is of kind float32 (float32) but int was specified")
+	assert.ErrorContains(t, err, "line 7: property CCSPlayerPawn.m_iMissing not found in schema")
+	assert.Equal(t, kindInt, classes[0].Properties[0].Kind)
+	assert.Equal(t, "Weapon", classes[0].Properties[2].Method)
+	assert.Equal(t, "weapon", classes[0].Properties[2].Field)
+}
+
+func TestResolve_All(t *testing.T) {
+	classes, err := parseSpec(strings.NewReader("CCSPlayerPawn\n\t*\n"))
+	assert.NoError(t, err)
+
+	schema := st.Schema{Classes: []st.ClassSchema{{Name: "CCSPlayerPawn", Fields: []st.FieldSchema{
+		{Name: "m_iHealth", VarType: "int32", Model: "simple"},
+		{Name: "m_pWeaponServices.m_hMyWeapons", VarType: "CNetworkUtlVectorBase< CHandle< CBasePlayerWeapon > >", Model: "variable-array"},
+		{Name: "m_pMovementServices.m_iHealth", VarType: "int32", Model: "simple"},
+	}}}}
+
+	err = resolve(classes, &schema)
+	assert.NoError(t, err)
+
+	if assert.Len(t, classes[0].Properties, 2) {
+		assert.Equal(t, "Health", classes[0].Properties[0].Method)
+		assert.Equal(t, "MovementServicesHealth", classes[0].Properties[1].Method)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	classes, err := parseSpec(strings.NewReader("CInferno\n\tm_fireCount int\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, resolve(classes, nil))
+
+	src, err := generate("entities", classes)
+
+	assert.NoError(t, err)
+	assert.Contains(t, string(src), "func BindCInferno(entity st.Entity) (*CInferno, error) {")
+	assert.Contains(t, string(src), "func (e *CInferno) FireCount() int {")
+	assert.NotContains(t, string(src), "r3")
+}
+
+func TestResolve_Selector(t *testing.T) {
+	classes, err := parseSpec(strings.NewReader(`
+Weapon with m_iItemDefinitionIndex m_iClip1
+	m_iClip1
+	m_hOwnerEntity handle
+`))
+	assert.NoError(t, err)
+
+	weapon := func(name string, fields ...st.FieldSchema) st.ClassSchema {
+		return st.ClassSchema{Name: name, Fields: append([]st.FieldSchema{
+			{Name: "m_iItemDefinitionIndex", VarType: "uint16", Model: "simple"},
+			{Name: "m_iClip1", VarType: "int32", Model: "simple"},
+		}, fields...)}
+	}
+
+	schema := st.Schema{Classes: []st.ClassSchema{
+		weapon("CAK47", st.FieldSchema{Name: "m_hOwnerEntity", VarType: "CHandle< CBaseEntity >", Model: "simple"}),
+		weapon("CKnife"),
+		{Name: "CCSPlayerPawn", Fields: []st.FieldSchema{{Name: "m_iHealth", VarType: "int32", Model: "simple"}}},
+	}}
+
+	err = resolve(classes, &schema)
+
+	assert.EqualError(t, err, "line 4: property CKnife.m_hOwnerEntity not found in schema")
+	assert.Equal(t, kindUInt32, classes[0].Properties[0].Kind)
+	assert.Equal(t, "entities of all server-classes with the properties m_iItemDefinitionIndex and m_iClip1", classes[0].Description())
+
+	classes, err = parseSpec(strings.NewReader("Hostage with m_leader\n\tm_leader handle\n"))
+	assert.NoError(t, err)
+	assert.EqualError(t, resolve(classes, &schema), "line 1: no class with properties m_leader found in schema")
+}
+
+func TestParseSpec_InvalidSelector(t *testing.T) {
+	_, err := parseSpec(strings.NewReader("Weapon m_iClip1\n"))
+
+	assert.EqualError(t, err, `line 1: expected "<class>" or "<name> with <property>..."`)
+}
+
+// TestSpec checks classes.txt against the schema of the test demo, like go:generate does.
+func TestSpec(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test due to -short flag")
+	}
+
+	specFile, err := os.Open("../classes.txt")
+	require.NoError(t, err)
+
+	defer specFile.Close()
+
+	classes, err := parseSpec(specFile)
+	require.NoError(t, err)
+
+	schema, err := readSchemaFromDemo("../../../../test/cs-demos/s2/s2.dem")
+	require.NoError(t, err)
+
+	assert.NoError(t, resolve(classes, schema))
+}
@@ -12,6 +12,7 @@ import (
 
 	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
 	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
 )
 
 // FrameDone signals that a demo-frame has been processed.
@@ -44,6 +45,19 @@ type RoundFreezetimeChanged struct {
 	NewIsFreezetime bool
 }
 
+// RoundPreStart signals that a new round is about to start, before players are respawned.
+type RoundPreStart struct{}
+
+// RoundPostStart signals that a new round has started, after players have been respawned.
+type RoundPostStart struct{}
+
+// RoundTimeWarning signals the warning that the round time is about to run out.
+type RoundTimeWarning struct{}
+
+// BuyTimeEnded signals that players can no longer buy equipment this round.
+// Mimicked for CS2 demos from the end of the freeze time and mp_buytime (20 seconds if unknown).
+type BuyTimeEnded struct{}
+
 // RoundEndReason is the type for the various RoundEndReasonXYZ constants.
 //
 // See RoundEnd.
@@ -119,6 +133,17 @@ type AnnouncementFinalRound struct{}
 // AnnouncementWinPanelMatch signals that the 'win panel' has been displayed. I guess that's the final scoreboard.
 type AnnouncementWinPanelMatch struct{}
 
+// AnnouncementWinPanelRound signals that the round 'win panel' has been displayed.
+type AnnouncementWinPanelRound struct {
+	FinalEvent    int    // Raw final_event value of the game-event
+	FunFactToken  string // Localization token of the fun-fact, e.g. "#GameUI_Stat_LastMatch_Kills"
+	FunFactPlayer *common.Player
+	FunFactData   [3]int
+}
+
+// AnnouncementMatchPoint signals the match point announcement.
+type AnnouncementMatchPoint struct{}
+
 // Footstep occurs when a player makes a footstep.
 type Footstep struct {
 	Player *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
@@ -149,12 +174,166 @@ type PlayerJump struct {
 }
 
 // PlayerSound signals that a player emitted a sound.
+// Only dispatched for the player_sound game-event, see SoundPlayed for sounds of CS2 demos.
 type PlayerSound struct {
 	Player   *common.Player
 	Radius   int
 	Duration time.Duration
 }
 
+// SoundSource is the type for the various SoundSourceXYZ constants.
+type SoundSource byte
+
+// SoundSource constants give information about which net-message a sound came from.
+const (
+	SoundSourceSounds      SoundSource = iota // svc_Sounds
+	SoundSourceWeaponSound                    // CCSUsrMsg_WeaponSound, weapon sounds of players outside of the PVS
+	SoundSourceSoundEvent                     // GE_SosStartSoundEvent
+)
+
+// SoundPlayed signals that a sound was played.
+// See package sounds for the mapping of SoundHash to SoundName.
+type SoundPlayed struct {
+	Source    SoundSource
+	Origin    r3.Vector      // Position of the sound or of the entity that emitted it, zero if unknown
+	SoundHash uint32         // Hash of the sound event name
+	SoundName string         // Name of the sound event, e.g. "Weapon_AK47.Single". Empty if unknown
+	Entity    st.Entity      // Entity that emitted the sound, may be nil
+	Player    *common.Player // Player that emitted the sound (or owns the weapon that did), may be nil
+	Volume    int            // Raw volume of svc_Sounds messages, 0 if unknown
+	Radius    float64        // Approximate distance (in world units) at which the sound is audible, 0 if unknown. Based on sounds.Level() for SoundSourceSoundEvent
+}
+
+// Hearers returns the players out of the given ones that could have heard the sound,
+// i.e. alive players within Radius of Origin, excluding the player that emitted the sound.
+// Walls and other obstacles aren't taken into account.
+// Returns nil if Radius or Origin are unknown.
+//
+// Example:
+//
+//	e.Hearers(parser.GameState().Participants().Playing())
+func (e SoundPlayed) Hearers(players []*common.Player) []*common.Player {
+	if e.Radius <= 0 || e.Origin == (r3.Vector{}) {
+		return nil
+	}
+
+	var hearers []*common.Player
+
+	for _, pl := range players {
+		if pl == nil || pl == e.Player || pl.Entity == nil || !pl.IsAlive() {
+			continue
+		}
+
+		if pl.Position().Sub(e.Origin).Norm() <= e.Radius {
+			hearers = append(hearers, pl)
+		}
+	}
+
+	return hearers
+}
+
+// PlayerSpawn signals that a player has spawned.
+type PlayerSpawn struct {
+	Player *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
+}
+
+// PlayerPing signals that a player used the 'ping system'.
+// Mimicked for CS2 demos from CPlayerPing entities.
+type PlayerPing struct {
+	Player   *common.Player
+	Position r3.Vector
+	Urgent   bool
+}
+
+// PlayerEnteredBombZone signals that a player entered a bomb site.
+// Mimicked for CS2 demos from the m_bInBombZone property of the player pawn.
+type PlayerEnteredBombZone struct {
+	Player *common.Player
+}
+
+// PlayerLeftBombZone signals that a player left a bomb site.
+// Mimicked for CS2 demos from the m_bInBombZone property of the player pawn.
+type PlayerLeftBombZone struct {
+	Player *common.Player
+}
+
+// PlayerEnteredBuyZone signals that a player entered a buy zone.
+// Mimicked for CS2 demos from the m_bInBuyZone property of the player pawn.
+type PlayerEnteredBuyZone struct {
+	Player *common.Player
+}
+
+// PlayerLeftBuyZone signals that a player left a buy zone.
+// Mimicked for CS2 demos from the m_bInBuyZone property of the player pawn.
+type PlayerLeftBuyZone struct {
+	Player *common.Player
+}
+
+// VoteStarted signals that a player (or the server) called a vote.
+// See also: GameState.ActiveVote()
+type VoteStarted struct {
+	Vote   *common.Vote
+	Issuer *common.Player // nil if the vote was started by the server or the player is unknown
+	Issue  common.VoteIssue
+	Target *common.Player // Player that the vote is about (e.g. kick votes), may be nil
+	Team   common.Team    // Team that is allowed to vote, TeamUnassigned if everyone can vote
+}
+
+// VoteCast signals that a player voted, e.g. for a timeout or a surrender.
+// Only present in locally recorded (POV) demos.
+type VoteCast struct {
+	Vote   *common.Vote // The vote that the player voted on, may be nil if the start of the vote wasn't recorded
+	Player *common.Player
+	Option int // Index of the chosen option, usually 0 = yes & 1 = no
+	Team   common.Team
+}
+
+// VoteEnded signals that a vote has passed or failed.
+type VoteEnded struct {
+	Vote   *common.Vote // May be nil if the start of the vote wasn't recorded
+	Passed bool
+	Yes    int
+	No     int
+	Reason int // Raw reason why the vote failed (e.g. not enough votes), 0 if it passed
+}
+
+// VoteCallFailed signals that a player tried to call a vote but wasn't allowed to.
+// Only present in locally recorded (POV) demos.
+type VoteCallFailed struct {
+	Reason int // Raw reason why the vote couldn't be called
+	Time   int // Seconds until the player may call a vote again, if the reason is a cool-down
+}
+
+// HLTVChase signals that the GOTV camera started chasing a player.
+type HLTVChase struct {
+	Target1  *common.Player // Primary target
+	Target2  *common.Player // Secondary target, may be nil
+	Distance int
+	Theta    int
+	Phi      int
+	Inertia  int
+	InEye    bool // True if the camera is in first person view
+}
+
+// HLTVFixed signals that the GOTV camera switched to a fixed position.
+type HLTVFixed struct {
+	Position r3.Vector
+	Theta    int
+	Phi      int
+	Offset   int
+	FOV      float32
+	Target   *common.Player // Player the camera is looking at, may be nil
+}
+
+// ObserverTargetChanged signals that the camera of a spectating or dead player started following another player.
+// Dispatched at the end of the frame. See also Player.ObserverTarget() and HLTVChase for the GOTV camera.
+type ObserverTargetChanged struct {
+	Observer  *common.Player
+	OldTarget *common.Player // May be nil
+	NewTarget *common.Player // May be nil, e.g. if the observer switched to a free camera or respawned
+	Mode      common.ObserverMode
+}
+
 // Kill signals that a player has been killed.
 type Kill struct {
 	Weapon            *common.Equipment
@@ -186,11 +365,35 @@ type WeaponFire struct {
 	Weapon  *common.Equipment
 }
 
+// ShotTrace signals the path of a shot, correlated from WeaponFire, PlayerHurt and the
+// bullet impact & muzzle flash temp-entities of the same frame.
+// Dispatched at the end of the frame, after WeaponFire and PlayerHurt.
+// Only dispatched for firearms (pistols, SMGs, heavy weapons and rifles).
+type ShotTrace struct {
+	Shooter      *common.Player
+	Weapon       *common.Equipment
+	Origin       r3.Vector      // Position of the muzzle flash if available, otherwise the shooter's eye position
+	ImpactPoints []r3.Vector    // Bullet impacts on the world and players, may be empty (e.g. if no temp-entities were networked)
+	HitPlayer    *common.Player // nil if no player was hit
+	HitGroup     HitGroup       // HitGroupGeneric if no player was hit
+}
+
 // WeaponReload signals that a player started to reload his weapon.
 type WeaponReload struct {
 	Player *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
 }
 
+// WeaponZoom signals that a player zoomed in (or out) with a scoped weapon.
+type WeaponZoom struct {
+	Player *common.Player // May be nil if the demo is partially corrupt (player is 'unconnected', see #156 and #172).
+}
+
+// WeaponInspect signals that a player inspected their weapon.
+// Mimicked for CS2 demos from the m_bIsLookingAtWeapon property of the player pawn.
+type WeaponInspect struct {
+	Player *common.Player
+}
+
 // GrenadeEventIf is the interface for all GrenadeEvents (except GrenadeProjectile* events).
 // Used to catch the different events with the same handler.
 type GrenadeEventIf interface {
@@ -459,8 +662,23 @@ type BotConnect struct {
 }
 
 // PlayerDisconnected signals that a player has disconnected.
+//
+// Dispatched at the end of the frame in which the player (or bot) disconnected, not immediately when the player's entity is updated,
+// so Reason is known regardless of the order of the player_disconnect game-event and the entity update within the frame.
+// Reason is NETWORK_DISCONNECT_INVALID if the player_disconnect game-event only arrives in a later frame than the entity update.
 type PlayerDisconnected struct {
 	Player *common.Player
+	Reason msg.ENetworkDisconnectionReason // From the player_disconnect game-event, NETWORK_DISCONNECT_INVALID if unknown
+}
+
+// PlayerReconnected signals that a player connected again after having disconnected earlier in the demo.
+// Dispatched after PlayerConnect.
+//
+// Player may be a new instance with a different UserID than before the disconnection,
+// use Identity to correlate the connections.
+type PlayerReconnected struct {
+	Player   *common.Player
+	Identity *common.PlayerIdentity
 }
 
 // PlayerNameChange signals that a player's name has changed.
@@ -505,13 +723,52 @@ type TickRateInfoAvailable struct {
 	TickTime time.Duration // See Parser.TickTime()
 }
 
+// ChatFlags describe who sent a chat or radio message and who it was sent to.
+type ChatFlags struct {
+	IsTeamOnly  bool // Only sent to the sender's team
+	IsDead      bool // Sent by a dead player, only visible to other dead players
+	IsSpectator bool // Sent by a spectator
+	IsCoach     bool // Sent by a coach
+}
+
 // ChatMessage signals a player generated chat message.
-// Since team chat is generally not recorded IsChatAll will probably always be false.
+// It's dispatched for CUserMessageSayText2 net-messages as well as player_chat game-events,
+// messages that are sent via both are only dispatched once.
+// Team chat is often not recorded in GOTV demos.
 // See SayText for admin / console messages and SayText2 for raw network package data.
 type ChatMessage struct {
 	Sender    *common.Player
 	Text      string
-	IsChatAll bool
+	IsChatAll bool // Same as !IsTeamOnly
+	ChatFlags
+}
+
+// RadioCommand signals that a player used a radio command (e.g. "Enemy spotted").
+// It contains the raw message name and parameters of the CCSUsrMsg_RadioText net-message,
+// e.g. MsgName = "#Game_radio_location" and Params = [name, location, "#Cstrike_TitlesTXT_Enemy_spotted"].
+// Radio commands are always sent to the player's team only.
+type RadioCommand struct {
+	Player  *common.Player // May be nil if the player is unknown
+	MsgName string
+	Params  []string
+	ChatFlags
+}
+
+// ServerPrintSource is the type for the various ServerPrintSourceXYZ constants.
+type ServerPrintSource byte
+
+// ServerPrintSource constants give information about which net-message a server print came from.
+const (
+	ServerPrintSourcePrint   ServerPrintSource = iota // svc_Print, e.g. console output of the server
+	ServerPrintSourceHudMsg                           // CCSUsrMsg_HudMsg, e.g. admin messages displayed on the HUD
+	ServerPrintSourceSayText                          // CUserMessageSayText, e.g. admin chat messages
+)
+
+// ServerPrint signals a message printed by the server or an admin (plugin).
+// Server prints aren't sent by players, so unlike ChatMessage and RadioCommand they have no sender or ChatFlags.
+type ServerPrint struct {
+	Source ServerPrintSource
+	Text   string
 }
 
 // RankUpdate signals the new rank. Not sure if this
@@ -575,6 +832,26 @@ type ItemDrop struct {
 // You can use the Parser.ServerClasses() after this event to register update notification on entities & properties.
 type DataTablesParsed struct{}
 
+// EntityCreated signals that an entity was created.
+// Only dispatched if ParserConfig.DispatchEntityEvents is enabled.
+type EntityCreated struct {
+	Entity      st.Entity
+	ServerClass st.ServerClass
+}
+
+// EntityUpdated signals that properties of an entity were updated.
+// Only dispatched if ParserConfig.DispatchEntityEvents is enabled.
+type EntityUpdated struct {
+	Entity            st.Entity
+	ChangedProperties []string // Names of the properties that were updated
+}
+
+// EntityDestroyed signals that an entity was destroyed.
+// Only dispatched if ParserConfig.DispatchEntityEvents is enabled.
+type EntityDestroyed struct {
+	Entity st.Entity
+}
+
 // StringTableCreated signals that a string table was created via net message.
 // Can be useful for figuring out when player-info is available via Parser.GameState().[Playing]Participants().
 // E.g. after the table 'userinfo' has been created the player-data should be available after the next FrameDone.
@@ -583,6 +860,63 @@ type StringTableCreated struct {
 	TableName string
 }
 
+// UserCmdButtons is a bit-mask of the buttons held by a player, see the UserCmdButtonXYZ constants.
+type UserCmdButtons uint64
+
+// UserCmdButtons constants, see InputBitMask_t in the CS2 SDK.
+const (
+	UserCmdButtonAttack       UserCmdButtons = 1 << 0
+	UserCmdButtonJump         UserCmdButtons = 1 << 1
+	UserCmdButtonDuck         UserCmdButtons = 1 << 2
+	UserCmdButtonForward      UserCmdButtons = 1 << 3
+	UserCmdButtonBack         UserCmdButtons = 1 << 4
+	UserCmdButtonUse          UserCmdButtons = 1 << 5
+	UserCmdButtonTurnLeft     UserCmdButtons = 1 << 7
+	UserCmdButtonTurnRight    UserCmdButtons = 1 << 8
+	UserCmdButtonMoveLeft     UserCmdButtons = 1 << 9
+	UserCmdButtonMoveRight    UserCmdButtons = 1 << 10
+	UserCmdButtonAttack2      UserCmdButtons = 1 << 11
+	UserCmdButtonReload       UserCmdButtons = 1 << 13
+	UserCmdButtonWalk         UserCmdButtons = 1 << 16 // IN_SPEED
+	UserCmdButtonUseOrReload  UserCmdButtons = 1 << 32
+	UserCmdButtonScore        UserCmdButtons = 1 << 33
+	UserCmdButtonZoom         UserCmdButtons = 1 << 34
+	UserCmdButtonLookAtWeapon UserCmdButtons = 1 << 35
+)
+
+// Has returns true if all of the given buttons are set.
+func (b UserCmdButtons) Has(buttons UserCmdButtons) bool {
+	return b&buttons == buttons
+}
+
+// SubtickMove is an input change that happened between two ticks, see UserCommand.
+type SubtickMove struct {
+	Button             UserCmdButtons // The button that was pressed or released, 0 for analog / view-angle changes
+	Pressed            bool
+	When               float32 // Fraction of the tick (0 to 1) at which the input happened
+	AnalogForwardDelta float32
+	AnalogLeftDelta    float32
+	PitchDelta         float32
+	YawDelta           float32
+}
+
+// UserCommand signals that the input of a player (a 'user command') was decoded.
+// Only available in POV demos (CDemoUserCmd, for the recording player)
+// and in demos that contain CSVCMsg_UserCommands net-messages (e.g. some CSTV demos).
+type UserCommand struct {
+	Player        *common.Player // May be nil if the player is unknown
+	Tick          int            // Ingame tick at which the command was executed by the server
+	ClientTick    int            // Tick of the client when the command was created
+	CommandNumber int
+	ViewAngles    r3.Vector // X = pitch, Y = yaw, Z = roll, in degrees
+	Buttons       UserCmdButtons
+	ForwardMove   float32 // -1 to 1
+	SideMove      float32 // -1 (right) to 1 (left)
+	SubtickMoves  []SubtickMove
+	Weapon        *common.Equipment  // Weapon the player is switching to, nil if not switching weapons
+	Command       *msg.CSGOUserCmdPB // The raw user command
+}
+
 // WarnType identifies a kind of warning for the ParserWarn event.
 type WarnType int
 
@@ -597,6 +931,7 @@ const (
 	WarnTypeStringTableParsingFailure // Should happen only with CS2 POV demos
 	WarnTypePacketEntitiesPanic
 	WarnTypeUnknownProtobufMessage
+	WarnTypeUserCommandDecodingFailure // may occur if the user command protobufs need to be updated
 )
 
 // WarnTypeUnknownDemoCommandMessageType occurs when a demo-command message type is unknown - contact a maintainer.
@@ -610,9 +945,129 @@ type ParserWarn struct {
 
 // GenericGameEvent signals any game-event.
 // It contains the raw data as received from the net-message.
+// See Values() for decoded values and Player() & Entity() for looking up referenced players and entities.
 type GenericGameEvent struct {
 	Name string
 	Data map[string]*msg.CMsgSource1LegacyGameEventKeyT
+
+	// Resolver is used to look up players & entities, see Player() and Entity().
+	// Set by the parser, may be nil if the event wasn't dispatched by it.
+	Resolver GameEventResolver
+}
+
+// GameEventKeyType is the type of the value of a game-event key.
+type GameEventKeyType int32
+
+// Possible types of game-event keys.
+const (
+	GameEventKeyTypeString           GameEventKeyType = 1
+	GameEventKeyTypeFloat            GameEventKeyType = 2
+	GameEventKeyTypeLong             GameEventKeyType = 3
+	GameEventKeyTypeShort            GameEventKeyType = 4
+	GameEventKeyTypeByte             GameEventKeyType = 5
+	GameEventKeyTypeBool             GameEventKeyType = 6
+	GameEventKeyTypeUint64           GameEventKeyType = 7
+	GameEventKeyTypePlayerController GameEventKeyType = 8 // Entity index of a player controller (usually 'userid' etc.)
+	GameEventKeyTypePlayerPawn       GameEventKeyType = 9 // Entity handle of a player pawn (usually 'userid_pawn' etc.)
+)
+
+// GameEventResolver looks up players and entities that are referenced by game-events.
+// Implemented by the parser, see GenericGameEvent.Resolver.
+type GameEventResolver interface {
+	// PlayerByUserID returns the player with the given user-id (as used by keys like 'userid' and 'attacker') or nil.
+	PlayerByUserID(userID int) *common.Player
+	// PlayerByPawnHandle returns the player of the given pawn entity handle or nil.
+	PlayerByPawnHandle(handle uint64) *common.Player
+	// EntityByID returns the entity with the given ID (as used by keys like 'entindex' and 'entityid') or nil.
+	EntityByID(id int) st.Entity
+}
+
+// Values returns the values of all keys of the game-event.
+// The types of the values depend on the type of the key (see GameEventKeyType):
+// string, float32, int32 (long, short, byte, player controller & pawn), bool or uint64.
+func (e GenericGameEvent) Values() map[string]any {
+	values := make(map[string]any, len(e.Data))
+
+	for k, v := range e.Data {
+		values[k] = gameEventKeyValue(v)
+	}
+
+	return values
+}
+
+func gameEventKeyValue(key *msg.CMsgSource1LegacyGameEventKeyT) any {
+	switch GameEventKeyType(key.GetType()) {
+	case GameEventKeyTypeString:
+		return key.GetValString()
+	case GameEventKeyTypeFloat:
+		return key.GetValFloat()
+	case GameEventKeyTypeLong, GameEventKeyTypePlayerPawn:
+		return key.GetValLong()
+	case GameEventKeyTypeShort, GameEventKeyTypePlayerController:
+		return key.GetValShort()
+	case GameEventKeyTypeByte:
+		return key.GetValByte()
+	case GameEventKeyTypeBool:
+		return key.GetValBool()
+	case GameEventKeyTypeUint64:
+		return key.GetValUint64()
+	}
+
+	// unknown type, use whichever value is set
+	switch {
+	case key.ValString != nil:
+		return key.GetValString()
+	case key.ValFloat != nil:
+		return key.GetValFloat()
+	case key.ValLong != nil:
+		return key.GetValLong()
+	case key.ValShort != nil:
+		return key.GetValShort()
+	case key.ValByte != nil:
+		return key.GetValByte()
+	case key.ValBool != nil:
+		return key.GetValBool()
+	case key.ValUint64 != nil:
+		return key.GetValUint64()
+	}
+
+	return nil
+}
+
+// Player returns the player referenced by the key (e.g. 'userid', 'attacker', 'assister' or 'userid_pawn').
+// Returns nil if the key doesn't exist, the player isn't known or Resolver is nil.
+func (e GenericGameEvent) Player(key string) *common.Player {
+	val, ok := e.Data[key]
+	if !ok || e.Resolver == nil {
+		return nil
+	}
+
+	if GameEventKeyType(val.GetType()) == GameEventKeyTypePlayerPawn {
+		return e.Resolver.PlayerByPawnHandle(uint64(val.GetValLong()))
+	}
+
+	userID, ok := gameEventKeyValue(val).(int32)
+	if !ok {
+		return nil
+	}
+
+	return e.Resolver.PlayerByUserID(int(userID))
+}
+
+// Entity returns the entity referenced by the key (e.g. 'entindex' or 'entityid').
+// Returns nil if the key doesn't exist, the entity isn't known or Resolver is nil.
+func (e GenericGameEvent) Entity(key string) st.Entity {
+	val, ok := e.Data[key]
+	if !ok || e.Resolver == nil {
+		return nil
+	}
+
+	id, ok := gameEventKeyValue(val).(int32)
+	if !ok {
+		return nil
+	}
+
+	return e.Resolver.EntityByID(int(id))
 }
 
 // InfernoStart signals that the fire of a incendiary or Molotov is starting.
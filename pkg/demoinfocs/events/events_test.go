@@ -4,10 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/geo/r3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 
 	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
 	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
 )
 
 func TestPlayerFlashed_FlashDuration(t *testing.T) {
@@ -55,6 +59,157 @@ func TestKill_IsWallBang(t *testing.T) {
 	assert.True(t, event.IsWallBang())
 }
 
+func TestGenericGameEvent_Values(t *testing.T) {
+	event := GenericGameEvent{
+		Name: "test",
+		Data: map[string]*msg.CMsgSource1LegacyGameEventKeyT{
+			"str":     {Type: proto.Int32(1), ValString: proto.String("abc")},
+			"float":   {Type: proto.Int32(2), ValFloat: proto.Float32(1.5)},
+			"long":    {Type: proto.Int32(3), ValLong: proto.Int32(100000)},
+			"short":   {Type: proto.Int32(4), ValShort: proto.Int32(100)},
+			"byte":    {Type: proto.Int32(5), ValByte: proto.Int32(5)},
+			"bool":    {Type: proto.Int32(6), ValBool: proto.Bool(true)},
+			"uint64":  {Type: proto.Int32(7), ValUint64: proto.Uint64(76561198000000000)},
+			"userid":  {Type: proto.Int32(8), ValShort: proto.Int32(3)},
+			"pawn":    {Type: proto.Int32(9), ValLong: proto.Int32(12345)},
+			"untyped": {ValString: proto.String("xyz")},
+		},
+	}
+
+	expected := map[string]any{
+		"str":     "abc",
+		"float":   float32(1.5),
+		"long":    int32(100000),
+		"short":   int32(100),
+		"byte":    int32(5),
+		"bool":    true,
+		"uint64":  uint64(76561198000000000),
+		"userid":  int32(3),
+		"pawn":    int32(12345),
+		"untyped": "xyz",
+	}
+
+	assert.Equal(t, expected, event.Values())
+}
+
+func TestGenericGameEvent_Player(t *testing.T) {
+	pl := &common.Player{Name: "by user-id"}
+	pawnPl := &common.Player{Name: "by pawn"}
+
+	event := GenericGameEvent{
+		Data: map[string]*msg.CMsgSource1LegacyGameEventKeyT{
+			"userid":      {Type: proto.Int32(8), ValShort: proto.Int32(3)},
+			"userid_pawn": {Type: proto.Int32(9), ValLong: proto.Int32(12345)},
+			"attacker":    {Type: proto.Int32(8), ValShort: proto.Int32(4)},
+			"weapon":      {Type: proto.Int32(1), ValString: proto.String("ak47")},
+		},
+		Resolver: gameEventResolverMock{
+			playersByUserID:     map[int]*common.Player{3: pl},
+			playersByPawnHandle: map[uint64]*common.Player{12345: pawnPl},
+		},
+	}
+
+	assert.Equal(t, pl, event.Player("userid"))
+	assert.Equal(t, pawnPl, event.Player("userid_pawn"))
+	assert.Nil(t, event.Player("attacker"))
+	assert.Nil(t, event.Player("weapon"))
+	assert.Nil(t, event.Player("missing"))
+
+	event.Resolver = nil
+
+	assert.Nil(t, event.Player("userid"))
+}
+
+func TestGenericGameEvent_Entity(t *testing.T) {
+	entity := new(stfake.Entity)
+
+	event := GenericGameEvent{
+		Data: map[string]*msg.CMsgSource1LegacyGameEventKeyT{
+			"entindex": {Type: proto.Int32(4), ValShort: proto.Int32(42)},
+			"entityid": {Type: proto.Int32(3), ValLong: proto.Int32(43)},
+		},
+		Resolver: gameEventResolverMock{
+			entities: map[int]st.Entity{42: entity},
+		},
+	}
+
+	assert.Equal(t, entity, event.Entity("entindex"))
+	assert.Nil(t, event.Entity("entityid"))
+	assert.Nil(t, event.Entity("missing"))
+}
+
+func TestSoundPlayed_Hearers(t *testing.T) {
+	newPlayerAt := func(pos r3.Vector, alive bool) *common.Player {
+		health, lifeState := int32(100), uint64(0)
+		if !alive {
+			health, lifeState = 0, 2
+		}
+
+		pawn := new(stfake.Entity)
+		pawn.On("Position").Return(pos)
+		pawn.On("PropertyValueMust", "m_iHealth").Return(st.PropertyValue{Any: health})
+		pawn.On("PropertyValueMust", "m_lifeState").Return(st.PropertyValue{Any: lifeState})
+
+		controller := new(stfake.Entity)
+		controller.On("PropertyValue", "m_hPawn").Return(st.PropertyValue{Any: uint64(1)}, true)
+		controller.On("PropertyValue", "m_hPlayerPawn").Return(st.PropertyValue{Any: uint64(1)}, true)
+
+		pl := common.NewPlayer(soundDemoInfoProviderMock{pawn: pawn})
+		pl.Entity = controller
+
+		return pl
+	}
+
+	emitter := newPlayerAt(r3.Vector{}, true)
+	near := newPlayerAt(r3.Vector{X: 100}, true)
+	far := newPlayerAt(r3.Vector{X: 2000}, true)
+	dead := newPlayerAt(r3.Vector{X: 100}, false)
+
+	event := SoundPlayed{
+		Origin: r3.Vector{X: 1},
+		Player: emitter,
+		Radius: 1250,
+	}
+
+	assert.Equal(t, []*common.Player{near}, event.Hearers([]*common.Player{emitter, near, far, dead}))
+
+	event.Radius = 0
+
+	assert.Nil(t, event.Hearers([]*common.Player{near}))
+}
+
+type soundDemoInfoProviderMock struct {
+	demoInfoProviderMock
+	pawn st.Entity
+}
+
+func (p soundDemoInfoProviderMock) FindEntityByHandle(uint64) st.Entity {
+	return p.pawn
+}
+
+type gameEventResolverMock struct {
+	playersByUserID     map[int]*common.Player
+	playersByPawnHandle map[uint64]*common.Player
+	entities            map[int]st.Entity
+}
+
+func (r gameEventResolverMock) PlayerByUserID(userID int) *common.Player {
+	return r.playersByUserID[userID]
+}
+
+func (r gameEventResolverMock) PlayerByPawnHandle(handle uint64) *common.Player {
+	return r.playersByPawnHandle[handle]
+}
+
+func (r gameEventResolverMock) EntityByID(id int) st.Entity {
+	entity, ok := r.entities[id]
+	if !ok {
+		return nil
+	}
+
+	return entity
+}
+
 type demoInfoProviderMock struct{}
 
 func (p demoInfoProviderMock) FindEntityByHandle(handle uint64) st.Entity {
@@ -80,3 +235,7 @@ func (p demoInfoProviderMock) FindPlayerByPawnHandle(uint64) *common.Player {
 func (p demoInfoProviderMock) FindWeaponByEntityID(int) *common.Equipment {
 	return nil
 }
+
+func (p demoInfoProviderMock) EstimatedVelocity(*common.Player) r3.Vector {
+	return r3.Vector{}
+}
@@ -1,6 +1,7 @@
 package fake
 
 import (
+	"github.com/golang/geo/r3"
 	"github.com/stretchr/testify/mock"
 
 	demoinfocs "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs"
@@ -55,6 +56,16 @@ func (gs *GameState) Infernos() map[int]*common.Inferno {
 	return gs.Called().Get(0).(map[int]*common.Inferno)
 }
 
+// Smokes is a mock-implementation of GameState.Smokes().
+func (gs *GameState) Smokes() map[int]*common.Smoke {
+	return gs.Called().Get(0).(map[int]*common.Smoke)
+}
+
+// LineIntersectsSmoke is a mock-implementation of GameState.LineIntersectsSmoke().
+func (gs *GameState) LineIntersectsSmoke(a, b r3.Vector) bool {
+	return gs.Called(a, b).Bool(0)
+}
+
 // Weapons is a mock-implementation of GameState.Weapons().
 func (gs *GameState) Weapons() map[int]*common.Equipment {
 	return gs.Called().Get(0).(map[int]*common.Equipment)
@@ -70,6 +81,11 @@ func (gs *GameState) Bomb() *common.Bomb {
 	return gs.Called().Get(0).(*common.Bomb)
 }
 
+// ActiveVote is a mock-implementation of GameState.ActiveVote().
+func (gs *GameState) ActiveVote() *common.Vote {
+	return gs.Called().Get(0).(*common.Vote)
+}
+
 // TotalRoundsPlayed is a mock-implementation of GameState.TotalRoundsPlayed().
 func (gs *GameState) TotalRoundsPlayed() int {
 	return gs.Called().Int(0)
@@ -90,11 +90,31 @@ func (p *Parser) ServerClasses() st.ServerClasses {
 	return p.Called().Get(0).(st.ServerClasses)
 }
 
+// GameEventDescriptors is a mock-implementation of Parser.GameEventDescriptors().
+func (p *Parser) GameEventDescriptors() []demoinfocs.GameEventDescriptor {
+	return p.Called().Get(0).([]demoinfocs.GameEventDescriptor)
+}
+
+// MatchInfo is a mock-implementation of Parser.MatchInfo().
+func (p *Parser) MatchInfo() demoinfocs.MatchInfo {
+	return p.Called().Get(0).(demoinfocs.MatchInfo)
+}
+
+// OnPropertyChange is a mock-implementation of Parser.OnPropertyChange().
+func (p *Parser) OnPropertyChange(classPattern, propPattern string, handler st.PropertyChangeHandler) error {
+	return p.Called(classPattern, propPattern, handler).Error(0)
+}
+
 // GameState is a mock-implementation of Parser.GameState().
 func (p *Parser) GameState() demoinfocs.GameState {
 	return p.Called().Get(0).(demoinfocs.GameState)
 }
 
+// Snapshot is a mock-implementation of Parser.Snapshot().
+func (p *Parser) Snapshot() *demoinfocs.Snapshot {
+	return p.Called().Get(0).(*demoinfocs.Snapshot)
+}
+
 // CurrentFrame is a mock-implementation of Parser.CurrentFrame().
 func (p *Parser) CurrentFrame() int {
 	return p.Called().Int(0)
@@ -29,6 +29,16 @@ func (ptcp *Participants) AllByUserID() map[int]*common.Player {
 	return ptcp.Called().Get(0).(map[int]*common.Player)
 }
 
+// BySteamID64 is a mock-implementation of Participants.BySteamID64().
+func (ptcp *Participants) BySteamID64() map[uint64]*common.Player {
+	return ptcp.Called().Get(0).(map[uint64]*common.Player)
+}
+
+// Identities is a mock-implementation of Participants.Identities().
+func (ptcp *Participants) Identities() map[uint64]*common.PlayerIdentity {
+	return ptcp.Called().Get(0).(map[uint64]*common.PlayerIdentity)
+}
+
 // All is a mock-implementation of Participants.All().
 func (ptcp *Participants) All() []*common.Player {
 	return ptcp.Called().Get(0).([]*common.Player)
@@ -3,6 +3,7 @@ package demoinfocs
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/golang/geo/r3"
@@ -13,6 +14,7 @@ import (
 	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
 	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
 	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
 )
 
 func (p *parser) handleGameEventList(gel *msg.CMsgSource1LegacyGameEventList) {
@@ -59,11 +61,29 @@ func (p *parser) handleGameEvent(ge *msg.CMsgSource1LegacyGameEvent) {
 	}
 
 	p.eventDispatcher.Dispatch(events.GenericGameEvent{
-		Name: desc.GetName(),
-		Data: data,
+		Name:     desc.GetName(),
+		Data:     data,
+		Resolver: gameEventResolver{parser: p},
 	})
 }
 
+// gameEventResolver implements events.GameEventResolver.
+type gameEventResolver struct {
+	parser *parser
+}
+
+func (r gameEventResolver) PlayerByUserID(userID int) *common.Player {
+	return r.parser.gameEventHandler.playerByUserID32(int32(userID))
+}
+
+func (r gameEventResolver) PlayerByPawnHandle(handle uint64) *common.Player {
+	return r.parser.gameState.Participants().FindByPawnHandle(handle)
+}
+
+func (r gameEventResolver) EntityByID(id int) st.Entity {
+	return r.parser.gameState.entities[id]
+}
+
 type gameEventHandler struct {
 	parser                      *parser
 	gameEventNameToHandler      map[string]gameEventHandlerFunc
@@ -150,7 +170,7 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"bomb_planted":                    delayIfNoPlayers(geh.bombPlanted),     // Plant finished
 		"bot_takeover":                    delay(geh.botTakeover),                // Bot got taken over
 		"bullet_damage":                   delayIfNoPlayers(geh.bulletDamage),    // CS2 only
-		"buytime_ended":                   nil,                                   // Not actually end of buy time, seems to only be sent once per game at the start
+		"buytime_ended":                   geh.buyTimeEnded,                      // Mimicked from the game rules as the event seems to only be sent once per game at the start
 		"choppers_incoming_warning":       nil,                                   // Helicopters are coming (Danger zone mode)
 		"cs_intermission":                 nil,                                   // Dunno, only in locally recorded (POV) demo
 		"cs_match_end_restart":            nil,                                   // Yawn
@@ -158,15 +178,15 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"cs_round_final_beep":             nil,                                   // Final beep
 		"cs_round_start_beep":             nil,                                   // Round start beeps
 		"cs_win_panel_match":              geh.csWinPanelMatch,                   // Not sure, maybe match end event???
-		"cs_win_panel_round":              nil,                                   // Win panel, (==end of match?)
+		"cs_win_panel_round":              geh.csWinPanelRound,                   // Round win panel
 		"decoy_detonate":                  geh.decoyDetonate,                     // Decoy exploded/expired
 		"decoy_started":                   delay(geh.decoyStarted),               // Decoy started. Delayed because projectile entity is not yet created
 		"endmatch_cmm_start_reveal_items": nil,                                   // Drops
 		"entity_visible":                  nil,                                   // Dunno, only in locally recorded (POV) demo
-		"enter_bombzone":                  nil,                                   // Dunno, only in locally recorded (POV) demo
-		"exit_bombzone":                   nil,                                   // Dunno, only in locally recorded (POV) demo
-		"enter_buyzone":                   nil,                                   // Dunno, only in locally recorded (POV) demo
-		"exit_buyzone":                    nil,                                   // Dunno, only in locally recorded (POV) demo
+		"enter_bombzone":                  geh.enterBombZone,                     // Only in locally recorded (POV) demos, mimicked from m_bInBombZone
+		"exit_bombzone":                   geh.exitBombZone,                      // Ditto
+		"enter_buyzone":                   geh.enterBuyZone,                      // Only in locally recorded (POV) demos, mimicked from m_bInBuyZone
+		"exit_buyzone":                    geh.exitBuyZone,                       // Ditto
 		"flashbang_detonate":              geh.flashBangDetonate,                 // Flash exploded
 		"firstbombs_incoming_warning":     nil,                                   // First wave artillery incoming (Danger zone mode)
 		"grenade_thrown":                  nil,                                   // CS2 only, not reliable as it's not always present in demos and always fired. You should use "weapon_fire".
@@ -175,15 +195,15 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"hostage_hurt":                    geh.hostageHurt,                       // Hostage hurt
 		"hostage_rescued":                 geh.hostageRescued,                    // Hostage rescued
 		"hostage_rescued_all":             geh.HostageRescuedAll,                 // All hostages rescued
-		"hltv_chase":                      nil,                                   // Don't care
-		"hltv_fixed":                      nil,                                   // Dunno
+		"hltv_chase":                      geh.hltvChase,                         // GOTV camera started chasing a player
+		"hltv_fixed":                      geh.hltvFixed,                         // GOTV camera switched to a fixed position
 		"hltv_message":                    nil,                                   // No clue
 		"hltv_status":                     nil,                                   // Don't know
 		"hltv_title":                      nil,                                   // Don't know
 		"hostname_changed":                nil,                                   // Only present in locally recorded (POV) demos
 		"inferno_expire":                  geh.infernoExpire,                     // Incendiary expired
 		"inferno_startburn":               delay(geh.infernoStartBurn),           // Incendiary exploded/started. Delayed because inferno entity is not yet created
-		"inspect_weapon":                  nil,                                   // Dunno, only in locally recorded (POV) demos
+		"inspect_weapon":                  geh.inspectWeapon,                     // Only in locally recorded (POV) demos, mimicked from m_bIsLookingAtWeapon
 		"item_equip":                      delay(geh.itemEquip),                  // Equipped / weapon swap, I think. Delayed because of #142 - Bot entity possibly not yet created
 		"item_pickup":                     delay(geh.itemPickup),                 // Picked up or bought? Delayed because of #119 - Equipment.UniqueID()
 		"item_pickup_slerp":               nil,                                   // Not sure, only in locally recorded (POV) demos
@@ -193,7 +213,7 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"player_activate":                 nil,                                   // CS2 POV demos
 		"player_blind":                    delay(geh.playerBlind),                // Player got blinded by a flash. Delayed because Player.FlashDuration hasn't been updated yet
 		"player_changename":               nil,                                   // Name change
-		"player_chat":                     nil,                                   // CS2 only, not always present and ChatMessage events are already dispatched through CUserMessageSayText2
+		"player_chat":                     delay(geh.playerChat),                 // CS2 only, not always present. Delayed so duplicates of CUserMessageSayText2 messages can be skipped
 		"player_connect":                  geh.playerConnect,                     // Bot connected or player reconnected, players normally come in via string tables & data tables
 		"player_connect_full":             nil,                                   // Connecting finished
 		"player_death":                    delayIfNoPlayers(geh.playerDeath),     // Player died
@@ -202,10 +222,10 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"player_footstep":                 delayIfNoPlayers(geh.playerFootstep),  // Footstep sound.- Delayed because otherwise Player might be nil
 		"player_hurt":                     geh.playerHurt,                        // Player got hurt
 		"player_jump":                     geh.playerJump,                        // Player jumped
-		"player_spawn":                    nil,                                   // Player spawn
+		"player_spawn":                    delayIfNoPlayers(geh.playerSpawn),     // Player spawn
 		"player_spawned":                  nil,                                   // Only present in locally recorded (POV) demos
 		"player_given_c4":                 nil,                                   // Dunno, only present in locally recorded (POV) demos
-		"player_ping":                     nil,                                   // When a player uses the "ping system" added with the operation Broken Fang, only present in locally recorded (POV) demos
+		"player_ping":                     geh.playerPing,                        // When a player uses the "ping system" added with the operation Broken Fang, only present in locally recorded (POV) demos, mimicked from CPlayerPing entities
 		"player_ping_stop":                nil,                                   // When a player's ping expired, only present in locally recorded (POV) demos
 		"player_sound":                    delayIfNoPlayers(geh.playerSound),     // When a player makes a sound
 
@@ -215,7 +235,7 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"player_team":                    delay(geh.playerTeam),
 		"round_announce_final":           geh.roundAnnounceFinal,           // 30th round for normal de_, not necessarily matchpoint
 		"round_announce_last_round_half": geh.roundAnnounceLastRoundHalf,   // Last round of the half
-		"round_announce_match_point":     nil,                              // Match point announcement
+		"round_announce_match_point":     geh.roundAnnounceMatchPoint,      // Match point announcement
 		"round_announce_match_start":     geh.roundAnnounceMatchStart,      // Special match start announcement
 		"round_announce_warmup":          nil,                              // Dunno
 		"round_end":                      geh.roundEnd,                     // Round ended and the winner was announced
@@ -223,10 +243,10 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"round_freeze_end":               geh.roundFreezeEnd,               // Round start freeze ended
 		"round_mvp":                      geh.roundMVP,                     // Round MVP was announced
 		"round_officially_ended":         geh.roundOfficiallyEnded,         // The event after which you get teleported to the spawn (=> You can still walk around between round_end and this event)
-		"round_poststart":                nil,                              // Ditto
-		"round_prestart":                 nil,                              // Ditto
+		"round_poststart":                geh.roundPostStart,               // Round started, after players have been respawned
+		"round_prestart":                 geh.roundPreStart,                // Round is about to start, before players are respawned
 		"round_start":                    geh.roundStart,                   // Round started
-		"round_time_warning":             nil,                              // Round time warning
+		"round_time_warning":             geh.roundTimeWarning,             // Round time warning
 		"server_cvar":                    nil,                              // Dunno
 		"show_survival_respawn_status":   nil,                              // Dunno, (Danger zone mode)
 		"survival_paradrop_spawn":        nil,                              // A paradrop is coming (Danger zone mode)
@@ -234,11 +254,11 @@ func newGameEventHandler(parser *parser, ignoreBombsiteIndexNotFound bool) gameE
 		"smokegrenade_expired":           geh.smokeGrenadeExpired,          // Smoke expired
 		"switch_team":                    nil,                              // Dunno, only present in POV demos
 		"tournament_reward":              nil,                              // Dunno
-		"vote_cast":                      nil,                              // Dunno, only present in POV demos
+		"vote_cast":                      geh.voteCast,                     // Only present in POV demos
 		"weapon_fire":                    delayIfNoPlayers(geh.weaponFire), // Weapon was fired
 		"weapon_fire_on_empty":           nil,                              // Sounds boring
 		"weapon_reload":                  geh.weaponReload,                 // Weapon reloaded
-		"weapon_zoom":                    nil,                              // Zooming in
+		"weapon_zoom":                    geh.weaponZoom,                   // Zooming in
 		"weapon_zoom_rifle":              nil,                              // Dunno, only in locally recorded (POV) demo
 		"entity_killed":                  nil,
 
@@ -300,6 +320,44 @@ func (geh gameEventHandler) roundAnnounceLastRoundHalf(map[string]*msg.CMsgSourc
 	geh.dispatch(events.AnnouncementLastRoundHalf{})
 }
 
+func (geh gameEventHandler) roundAnnounceMatchPoint(map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.AnnouncementMatchPoint{})
+}
+
+func (geh gameEventHandler) csWinPanelRound(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.AnnouncementWinPanelRound{
+		FinalEvent:    int(data["final_event"].GetValByte()),
+		FunFactToken:  data["funfact_token"].GetValString(),
+		FunFactPlayer: geh.playerByUserID32(data["funfact_player"].GetValShort()),
+		FunFactData: [3]int{
+			int(data["funfact_data1"].GetValLong()),
+			int(data["funfact_data2"].GetValLong()),
+			int(data["funfact_data3"].GetValLong()),
+		},
+	})
+}
+
+func (geh gameEventHandler) roundPreStart(map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.RoundPreStart{})
+}
+
+func (geh gameEventHandler) roundPostStart(map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.RoundPostStart{})
+}
+
+func (geh gameEventHandler) roundTimeWarning(map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.RoundTimeWarning{})
+}
+
+func (geh gameEventHandler) buyTimeEnded(map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	// the game event seems to only be sent once per game, so it's mimicked from the game rules instead
+	if !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
+	geh.dispatch(events.BuyTimeEnded{})
+}
+
 func (geh gameEventHandler) roundEnd(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
 	if !geh.parser.disableMimicSource1GameEvents {
 		return
@@ -382,6 +440,169 @@ func (geh gameEventHandler) playerSound(data map[string]*msg.CMsgSource1LegacyGa
 	})
 }
 
+func (geh gameEventHandler) playerChat(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	sender := geh.playerByUserID32(data["userid"].GetValShort())
+	teamOnly := data["teamonly"].GetValBool()
+
+	flags := events.ChatFlags{
+		IsTeamOnly: teamOnly,
+		IsCoach:    isCoach(sender),
+	}
+
+	if sender != nil {
+		flags.IsSpectator = sender.Team == common.TeamSpectators
+		flags.IsDead = !flags.IsSpectator && sender.Entity != nil && !sender.IsAlive()
+	}
+
+	geh.parser.dispatchChatMessage(events.ChatMessage{
+		Sender:    sender,
+		Text:      data["text"].GetValString(),
+		IsChatAll: !teamOnly,
+		ChatFlags: flags,
+	})
+}
+
+func (geh gameEventHandler) playerSpawn(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.PlayerSpawn{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+	})
+}
+
+func (geh gameEventHandler) playerPing(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	if !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
+	geh.dispatch(events.PlayerPing{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+		Position: r3.Vector{
+			X: float64(data["x"].GetValFloat()),
+			Y: float64(data["y"].GetValFloat()),
+			Z: float64(data["z"].GetValFloat()),
+		},
+		Urgent: data["urgent"].GetValBool(),
+	})
+}
+
+func (geh gameEventHandler) enterBombZone(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	if !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
+	geh.dispatch(events.PlayerEnteredBombZone{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+	})
+}
+
+func (geh gameEventHandler) exitBombZone(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	if !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
+	geh.dispatch(events.PlayerLeftBombZone{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+	})
+}
+
+func (geh gameEventHandler) enterBuyZone(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	if !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
+	geh.dispatch(events.PlayerEnteredBuyZone{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+	})
+}
+
+func (geh gameEventHandler) exitBuyZone(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	if !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
+	geh.dispatch(events.PlayerLeftBuyZone{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+	})
+}
+
+func (geh gameEventHandler) voteCast(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	vote := geh.gameState().activeVote
+	option := int(data["vote_option"].GetValByte())
+
+	// the vote counts are taken from the CVoteController entity if available
+	if vote != nil && geh.gameState().voteController == nil {
+		switch option {
+		case 0:
+			vote.YesVotes++
+		case 1:
+			vote.NoVotes++
+		}
+	}
+
+	geh.dispatch(events.VoteCast{
+		Vote:   vote,
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+		Option: option,
+		Team:   common.Team(data["team"].GetValShort()),
+	})
+}
+
+func (geh gameEventHandler) hltvChase(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.HLTVChase{
+		Target1:  geh.hltvChaseTarget(data["target1"]),
+		Target2:  geh.hltvChaseTarget(data["target2"]),
+		Distance: int(data["distance"].GetValShort()),
+		Theta:    int(data["theta"].GetValShort()),
+		Phi:      int(data["phi"].GetValShort()),
+		Inertia:  int(data["inertia"].GetValByte()),
+		InEye:    data["ineye"].GetValByte() != 0,
+	})
+}
+
+func (geh gameEventHandler) hltvFixed(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.HLTVFixed{
+		Position: r3.Vector{
+			X: float64(data["posx"].GetValLong()),
+			Y: float64(data["posy"].GetValLong()),
+			Z: float64(data["posz"].GetValLong()),
+		},
+		Theta:  int(data["theta"].GetValShort()),
+		Phi:    int(data["phi"].GetValShort()),
+		Offset: int(data["offset"].GetValShort()),
+		FOV:    data["fov"].GetValFloat(),
+		Target: geh.hltvChaseTarget(data["target"]),
+	})
+}
+
+// hltvChaseTarget returns the player of a hltv_chase target,
+// which is a player controller key in CS2 and an entity index in CS:GO.
+func (geh gameEventHandler) hltvChaseTarget(key *msg.CMsgSource1LegacyGameEventKeyT) *common.Player {
+	if key == nil {
+		return nil
+	}
+
+	if events.GameEventKeyType(key.GetType()) == events.GameEventKeyTypePlayerController {
+		return geh.playerByUserID32(key.GetValShort())
+	}
+
+	return geh.gameState().playersByEntityID[int(key.GetValShort())]
+}
+
+func (geh gameEventHandler) weaponZoom(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	geh.dispatch(events.WeaponZoom{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+	})
+}
+
+func (geh gameEventHandler) inspectWeapon(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	if !geh.parser.disableMimicSource1GameEvents {
+		return
+	}
+
+	geh.dispatch(events.WeaponInspect{
+		Player: geh.playerByUserID32(data["userid"].GetValShort()),
+	})
+}
+
 func (geh gameEventHandler) weaponFire(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
 	if !geh.parser.disableMimicSource1GameEvents {
 		return
@@ -526,8 +747,12 @@ func (geh gameEventHandler) flashBangDetonate(data map[string]*msg.CMsgSource1Le
 }
 
 func (geh gameEventHandler) heGrenadeDetonate(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	event := geh.nadeEvent(data, common.EqHE)
+
+	geh.deformSmokes(event.Position)
+
 	geh.dispatch(events.HeExplode{
-		GrenadeEvent: geh.nadeEvent(data, common.EqHE),
+		GrenadeEvent: event,
 	})
 }
 
@@ -549,8 +774,12 @@ func (geh gameEventHandler) decoyDetonate(data map[string]*msg.CMsgSource1Legacy
 }
 
 func (geh gameEventHandler) smokeGrenadeDetonate(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
+	event := geh.nadeEvent(data, common.EqSmoke)
+
+	geh.gameState().smokes[event.GrenadeEntityID] = geh.newSmoke(event)
+
 	geh.dispatch(events.SmokeStart{
-		GrenadeEvent: geh.nadeEvent(data, common.EqSmoke),
+		GrenadeEvent: event,
 	})
 }
 
@@ -560,9 +789,48 @@ func (geh gameEventHandler) smokeGrenadeExpired(data map[string]*msg.CMsgSource1
 		GrenadeEvent: event,
 	})
 
+	delete(geh.gameState().smokes, event.GrenadeEntityID)
 	geh.deleteThrownGrenade(event.Thrower, common.EqSmoke)
 }
 
+func (geh gameEventHandler) newSmoke(event events.GrenadeEvent) *common.Smoke {
+	position := event.Position
+	startTick := geh.gameState().ingameTick
+	entity := geh.gameState().entities[event.GrenadeEntityID]
+
+	// CS2 networks the exact detonation position and tick on the projectile
+	if entity != nil {
+		if val, ok := entity.PropertyValue("m_vSmokeDetonationPos"); ok && val.Any != nil {
+			position = val.R3Vec()
+		}
+
+		if val, ok := entity.PropertyValue("m_nSmokeEffectTickBegin"); ok && val.Any != nil && val.Int() > 0 {
+			startTick = val.Int()
+		}
+	}
+
+	return common.NewSmoke(geh.parser.demoInfoProvider, entity, event.Thrower, position, startTick)
+}
+
+// deformSmokes blows holes into all smokes that are in range of an HE grenade explosion.
+func (geh gameEventHandler) deformSmokes(position r3.Vector) {
+	tick := geh.gameState().ingameTick
+	duration := int(common.SmokeHoleDuration.Seconds() * geh.parser.TickRate())
+
+	for _, smoke := range geh.gameState().smokes {
+		if smoke.Position.Distance(position) > smoke.Radius()+common.SmokeHoleRadius {
+			continue
+		}
+
+		smoke.Holes = append(smoke.Holes, common.SmokeHole{
+			Position:  position,
+			Radius:    common.SmokeHoleRadius,
+			StartTick: tick,
+			EndTick:   tick + duration,
+		})
+	}
+}
+
 func (geh gameEventHandler) infernoStartBurn(data map[string]*msg.CMsgSource1LegacyGameEventKeyT) {
 	geh.dispatch(events.FireGrenadeStart{
 		GrenadeEvent: geh.nadeEvent(data, common.EqIncendiary),
@@ -654,13 +922,30 @@ func (geh gameEventHandler) playerDisconnect(data map[string]*msg.CMsgSource1Leg
 	}
 
 	pl := geh.playerByUserID(uid)
+	reason := msg.ENetworkDisconnectionReason(data["reason"].GetValShort())
 
 	if pl != nil && pl.IsBot {
-		geh.dispatch(events.PlayerDisconnected{
-			Player: pl,
+		pl.IsConnected = false
+
+		// dispatched at the end of the frame, like the PlayerDisconnected event of human players
+		geh.parser.delayedEventHandlers = append(geh.parser.delayedEventHandlers, func() {
+			geh.dispatch(events.PlayerDisconnected{
+				Player: pl,
+				Reason: reason,
+			})
 		})
 
-		pl.IsConnected = false
+		return
+	}
+
+	// the PlayerDisconnected event of human players is dispatched when the controller entity is updated
+	steamID64 := data["xuid"].GetValUint64()
+	if steamID64 == 0 && pl != nil {
+		steamID64 = pl.SteamID64
+	}
+
+	if steamID64 != 0 {
+		geh.parser.setDisconnectReason(steamID64, reason)
 	}
 }
 
@@ -1124,6 +1409,41 @@ func (p *parser) processRoundProgressEvents() {
 	p.dispatchMatchStartedEventIfNecessary()
 }
 
+// defaultBuyTime is the default value of mp_buytime in seconds.
+const defaultBuyTime = 20
+
+// updateBuyTimeEnd computes when the buy time ends once the freeze time is over.
+// Buy time starts at the end of the freeze time and lasts for mp_buytime seconds.
+func (p *parser) updateBuyTimeEnd(oldIsFreezetime, newIsFreezetime bool) {
+	if newIsFreezetime || !oldIsFreezetime {
+		p.gameState.buyTimeEndTick = 0
+
+		return
+	}
+
+	tickRate := p.TickRate()
+	if tickRate <= 0 {
+		return
+	}
+
+	buyTime, err := strconv.ParseFloat(p.gameState.rules.conVars["mp_buytime"], 64)
+	if err != nil {
+		buyTime = defaultBuyTime
+	}
+
+	p.gameState.buyTimeEndTick = p.gameState.ingameTick + int(math.Round(buyTime*tickRate))
+}
+
+func (p *parser) processBuyTimeEnd() {
+	if p.gameState.buyTimeEndTick == 0 || p.gameState.ingameTick < p.gameState.buyTimeEndTick {
+		return
+	}
+
+	p.gameState.buyTimeEndTick = 0
+
+	p.gameEventHandler.dispatch(events.BuyTimeEnded{})
+}
+
 func (p *parser) processFlyingFlashbangs() {
 	if len(p.gameState.flyingFlashbangs) == 0 {
 		return
@@ -1165,6 +1485,7 @@ func (p *parser) processFrameGameEvents() {
 	if !p.disableMimicSource1GameEvents {
 		p.processFlyingFlashbangs()
 		p.processRoundProgressEvents()
+		p.processBuyTimeEnd()
 	}
 
 	for _, eventHandler := range p.delayedEventHandlers {
@@ -1172,4 +1493,6 @@ func (p *parser) processFrameGameEvents() {
 	}
 
 	p.delayedEventHandlers = p.delayedEventHandlers[:0]
+
+	p.processShotTraces()
 }
@@ -4,6 +4,7 @@ import (
 	"crypto/rand"
 	"testing"
 
+	"github.com/golang/geo/r3"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
 
@@ -234,3 +235,227 @@ func TestGetCommunityId_Error(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, "strconv.ParseUint: parsing \"abc\": invalid syntax", err.Error())
 }
+
+func TestParser_GameEventDescriptors(t *testing.T) {
+	p := NewParser(rand.Reader).(*parser)
+
+	assert.Nil(t, p.GameEventDescriptors())
+
+	p.handleGameEventList(&msg.CMsgSource1LegacyGameEventList{
+		Descriptors: []*msg.CMsgSource1LegacyGameEventListDescriptorT{
+			{
+				Eventid: proto.Int32(2),
+				Name:    proto.String("bomb_beep"), // known but not mapped
+				Keys: []*msg.CMsgSource1LegacyGameEventListKeyT{
+					{Name: proto.String("userid"), Type: proto.Int32(8)},
+				},
+			},
+			{
+				Eventid: proto.Int32(1),
+				Name:    proto.String("round_end"),
+				Keys: []*msg.CMsgSource1LegacyGameEventListKeyT{
+					{Name: proto.String("winner"), Type: proto.Int32(5)},
+				},
+			},
+			{
+				Eventid: proto.Int32(3),
+				Name:    proto.String("some_new_event"),
+			},
+		},
+	})
+
+	expected := []GameEventDescriptor{
+		{
+			ID:     1,
+			Name:   "round_end",
+			Keys:   []GameEventKeyDescriptor{{Name: "winner", Type: events.GameEventKeyTypeByte}},
+			Mapped: true,
+		},
+		{
+			ID:   2,
+			Name: "bomb_beep",
+			Keys: []GameEventKeyDescriptor{{Name: "userid", Type: events.GameEventKeyTypePlayerController}},
+		},
+		{
+			ID:   3,
+			Name: "some_new_event",
+		},
+	}
+
+	assert.Equal(t, expected, p.GameEventDescriptors())
+}
+
+func TestBuyTimeEnded_Mimicked(t *testing.T) {
+	p := NewParser(rand.Reader).(*parser)
+	p.tickInterval = 1.0 / 64
+	p.gameState.rules.conVars["mp_buytime"] = "10"
+
+	dispatched := 0
+	p.RegisterEventHandler(func(events.BuyTimeEnded) {
+		dispatched++
+	})
+
+	p.gameState.ingameTick = 100
+	p.updateBuyTimeEnd(true, false)
+
+	assert.Equal(t, 740, p.gameState.buyTimeEndTick)
+
+	p.gameState.ingameTick = 739
+	p.processBuyTimeEnd()
+
+	assert.Zero(t, dispatched)
+
+	p.gameState.ingameTick = 740
+	p.processBuyTimeEnd()
+	p.processBuyTimeEnd()
+
+	assert.Equal(t, 1, dispatched)
+
+	// new round before the buy time is over
+	p.updateBuyTimeEnd(true, false)
+	p.updateBuyTimeEnd(false, true)
+
+	assert.Zero(t, p.gameState.buyTimeEndTick)
+}
+
+func TestHLTVChase(t *testing.T) {
+	p := NewParser(rand.Reader).(*parser)
+	p.disableMimicSource1GameEvents = true
+
+	pl1 := newPlayer()
+	pl2 := newPlayer()
+	p.gameState.playersByUserID[3] = pl1
+	p.gameState.playersByEntityID[7] = pl2
+
+	var event events.HLTVChase
+	p.RegisterEventHandler(func(e events.HLTVChase) {
+		event = e
+	})
+
+	p.gameEventDescs = map[int32]*msg.CMsgSource1LegacyGameEventListDescriptorT{
+		1: {
+			Name: proto.String("hltv_chase"),
+			Keys: []*msg.CMsgSource1LegacyGameEventListKeyT{
+				{Name: proto.String("target1")},
+				{Name: proto.String("target2")},
+				{Name: proto.String("distance")},
+				{Name: proto.String("ineye")},
+			},
+		},
+	}
+
+	p.handleGameEvent(&msg.CMsgSource1LegacyGameEvent{
+		Eventid: proto.Int32(1),
+		Keys: []*msg.CMsgSource1LegacyGameEventKeyT{
+			{Type: proto.Int32(int32(events.GameEventKeyTypePlayerController)), ValShort: proto.Int32(3)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypeShort)), ValShort: proto.Int32(7)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypeShort)), ValShort: proto.Int32(96)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypeByte)), ValByte: proto.Int32(1)},
+		},
+	})
+
+	assert.True(t, event.Target1 == pl1)
+	assert.True(t, event.Target2 == pl2)
+	assert.Equal(t, 96, event.Distance)
+	assert.True(t, event.InEye)
+}
+
+func TestHLTVFixed(t *testing.T) {
+	p := NewParser(rand.Reader).(*parser)
+	p.disableMimicSource1GameEvents = true
+
+	pl := newPlayer()
+	p.gameState.playersByUserID[3] = pl
+
+	var event events.HLTVFixed
+	p.RegisterEventHandler(func(e events.HLTVFixed) {
+		event = e
+	})
+
+	p.gameEventDescs = map[int32]*msg.CMsgSource1LegacyGameEventListDescriptorT{
+		1: {
+			Name: proto.String("hltv_fixed"),
+			Keys: []*msg.CMsgSource1LegacyGameEventListKeyT{
+				{Name: proto.String("posx")},
+				{Name: proto.String("posy")},
+				{Name: proto.String("posz")},
+				{Name: proto.String("theta")},
+				{Name: proto.String("fov")},
+				{Name: proto.String("target")},
+			},
+		},
+	}
+
+	p.handleGameEvent(&msg.CMsgSource1LegacyGameEvent{
+		Eventid: proto.Int32(1),
+		Keys: []*msg.CMsgSource1LegacyGameEventKeyT{
+			{Type: proto.Int32(int32(events.GameEventKeyTypeLong)), ValLong: proto.Int32(100)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypeLong)), ValLong: proto.Int32(-200)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypeLong)), ValLong: proto.Int32(64)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypeShort)), ValShort: proto.Int32(45)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypeFloat)), ValFloat: proto.Float32(90)},
+			{Type: proto.Int32(int32(events.GameEventKeyTypePlayerController)), ValShort: proto.Int32(3)},
+		},
+	})
+
+	assert.Equal(t, r3.Vector{X: 100, Y: -200, Z: 64}, event.Position)
+	assert.Equal(t, 45, event.Theta)
+	assert.Equal(t, float32(90), event.FOV)
+	assert.True(t, event.Target == pl)
+}
+
+func TestPlayerChat_SkipsDuplicateSayText2(t *testing.T) {
+	p := NewParser(rand.Reader).(*parser)
+	sender := newPlayerWithEntityID(1)
+	sender.Team = common.TeamSpectators
+	sender.Entity.(*stfake.Entity).On("PropertyValue", "m_iCoachingTeam").Return(st.PropertyValue{}, false)
+	sender.Entity.(*stfake.Entity).On("PropertyValue", "m_hPawn").Return(st.PropertyValue{}, false)
+	p.gameState.playersByEntityID[1] = sender
+	p.gameState.playersByUserID[0] = sender
+
+	var messages []events.ChatMessage
+	p.RegisterEventHandler(func(e events.ChatMessage) {
+		messages = append(messages, e)
+	})
+
+	p.gameEventDescs = map[int32]*msg.CMsgSource1LegacyGameEventListDescriptorT{
+		1: {
+			Name: proto.String("player_chat"),
+			Keys: []*msg.CMsgSource1LegacyGameEventListKeyT{
+				{Name: proto.String("teamonly")},
+				{Name: proto.String("userid")},
+				{Name: proto.String("text")},
+			},
+		},
+	}
+
+	chatEvent := func(text string) *msg.CMsgSource1LegacyGameEvent {
+		return &msg.CMsgSource1LegacyGameEvent{
+			Eventid: proto.Int32(1),
+			Keys: []*msg.CMsgSource1LegacyGameEventKeyT{
+				{ValBool: proto.Bool(true)},
+				{ValShort: proto.Int32(0)},
+				{ValString: proto.String(text)},
+			},
+		}
+	}
+
+	p.handleGameEvent(chatEvent("hello"))
+	p.handleGameEvent(chatEvent("only via game-event"))
+	p.handleMessageSayText2(&msg.CUserMessageSayText2{
+		Entityindex: proto.Int32(1),
+		Messagename: proto.String("Cstrike_Chat_Spec"),
+		Param1:      proto.String("player"),
+		Param2:      proto.String("hello"),
+	})
+
+	p.handleFrameParsed(nil)
+
+	expectedFlags := events.ChatFlags{IsTeamOnly: true, IsSpectator: true}
+
+	assert.Equal(t, []events.ChatMessage{
+		{Sender: sender, Text: "hello", ChatFlags: expectedFlags},
+		{Sender: sender, Text: "only via game-event", ChatFlags: expectedFlags},
+	}, messages)
+	assert.Empty(t, p.chatMessagesThisFrame)
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/golang/geo/r3"
+
 	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/constants"
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
@@ -20,16 +22,20 @@ type gameState struct {
 	ingameTick                   int
 	tState                       common.TeamState
 	ctState                      common.TeamState
-	playersByUserID              map[int]*common.Player    // Maps user-IDs to players
-	playersByEntityID            map[int]*common.Player    // Maps entity-IDs to players
-	playersBySteamID32           map[uint32]*common.Player // Maps 32-bit-steam-IDs to players
+	playersByUserID              map[int]*common.Player            // Maps user-IDs to players
+	playersByEntityID            map[int]*common.Player            // Maps entity-IDs to players
+	playersBySteamID32           map[uint32]*common.Player         // Maps 32-bit-steam-IDs to players
+	playerIdentities             map[uint64]*common.PlayerIdentity // Maps SteamID64s to identities of human players, kept across reconnects
 	playerControllerEntities     map[int]st.Entity
 	grenadeProjectiles           map[int]*common.GrenadeProjectile // Maps entity-IDs to active nade-projectiles. That's grenades that have been thrown, but have not yet detonated.
 	infernos                     map[int]*common.Inferno           // Maps entity-IDs to active infernos.
+	smokes                       map[int]*common.Smoke             // Maps entity-IDs of smoke grenade projectiles to active smoke clouds.
 	weapons                      map[int]*common.Equipment         // Maps entity IDs to weapons. Used to remember what a weapon is (p250 / cz etc.)
 	hostages                     map[int]*common.Hostage           // Maps entity-IDs to hostages.
 	entities                     map[int]st.Entity                 // Maps entity IDs to entities
 	bomb                         common.Bomb
+	activeVote                   *common.Vote // Vote that is currently in progress, nil if there is none
+	voteController               st.Entity    // CVoteController entity, nil if not (yet) created
 	totalRoundsPlayed            int
 	gamePhase                    common.GamePhase
 	isWarmupPeriod               bool
@@ -40,12 +46,14 @@ type gameState struct {
 	currentDefuser               *common.Player                                                  // Player currently defusing the bomb, if any
 	currentPlanter               *common.Player                                                  // Player currently planting the bomb, if any
 	thrownGrenades               map[*common.Player]map[common.EquipmentType][]*common.Equipment // Information about every player's thrown grenades (from the moment they are thrown to the moment their effect is ended)
+	velocityEstimates            map[*common.Player]*velocityEstimate                            // Velocities estimated from position changes for demos without velocity properties, see Player.Velocity()
 	rules                        gameRules
 	demoInfo                     demoInfoProvider
 	lastRoundStartEvent          *events.RoundStart             // Used to dispatch this event after a possible MatchStartedChanged event
 	lastFreezeTimeChangedEvent   *events.RoundFreezetimeChanged // Used to dispatch this event after a possible RoundStart event
 	lastRoundEndEvent            *events.RoundEnd               // Used to dispatch this event before a possible RoundFreezetimeChanged event
 	lastMatchStartedChangedEvent *events.MatchStartedChanged    // Used to dispatch this event before a possible RoundStart event and after a possible RoundEnd event
+	buyTimeEndTick               int                            // Ingame tick at which the buy time of the current round ends, 0 if not known or already over. Used to mimic buytime_ended events
 	// Used to mimic missing player_blind events for CS2 demos.
 	//
 	// When a player throws a flashbang the following happens:
@@ -128,8 +136,10 @@ func (gs *gameState) TeamTerrorists() *common.TeamState {
 // The struct contains references to the original maps so it's always up-to-date.
 func (gs gameState) Participants() Participants {
 	return participants{
-		playersByEntityID: gs.playersByEntityID,
-		playersByUserID:   gs.playersByUserID,
+		playersByEntityID:  gs.playersByEntityID,
+		playersByUserID:    gs.playersByUserID,
+		playersBySteamID32: gs.playersBySteamID32,
+		playerIdentities:   gs.playerIdentities,
 	}
 }
 
@@ -162,6 +172,24 @@ func (gs gameState) Infernos() map[int]*common.Inferno {
 	return gs.infernos
 }
 
+// Smokes returns a map from entity-IDs to all currently active smoke clouds.
+// The entity-ID is the one of the smoke grenade projectile.
+func (gs gameState) Smokes() map[int]*common.Smoke {
+	return gs.smokes
+}
+
+// LineIntersectsSmoke returns true if the line segment between a and b passes through any active smoke cloud.
+// See common.Smoke for the limitations of the smoke model.
+func (gs gameState) LineIntersectsSmoke(a, b r3.Vector) bool {
+	for _, smoke := range gs.smokes {
+		if smoke.IntersectsLine(a, b) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Weapons returns a map from entity-IDs to all weapons currently in the game.
 func (gs gameState) Weapons() map[int]*common.Equipment {
 	return gs.weapons
@@ -178,6 +206,12 @@ func (gs gameState) Bomb() *common.Bomb {
 	return &gs.bomb
 }
 
+// ActiveVote returns the vote that is currently in progress (e.g. a kick, surrender or timeout vote).
+// Returns nil if no vote is in progress.
+func (gs gameState) ActiveVote() *common.Vote {
+	return gs.activeVote
+}
+
 // TotalRoundsPlayed returns the amount of total rounds played according to CCSGameRulesProxy.
 func (gs gameState) TotalRoundsPlayed() int {
 	return gs.totalRoundsPlayed
@@ -208,6 +242,15 @@ func (gs gameState) OvertimeCount() int {
 	return gs.overtimeCount
 }
 
+// playerByClientSlot returns the player with the given client slot (entity ID - 1) or nil.
+func (gs gameState) playerByClientSlot(slot int32) *common.Player {
+	if slot < 0 {
+		return nil
+	}
+
+	return gs.playersByEntityID[int(slot)+1]
+}
+
 func entityIDFromHandle(handle uint64) int {
 	if handle == constants.InvalidEntityHandleSource2 {
 		return -1
@@ -228,8 +271,11 @@ func newGameState(demoInfo demoInfoProvider) *gameState {
 		playersByEntityID:        make(map[int]*common.Player),
 		playersByUserID:          make(map[int]*common.Player),
 		playersBySteamID32:       make(map[uint32]*common.Player),
+		playerIdentities:         make(map[uint64]*common.PlayerIdentity),
+		velocityEstimates:        make(map[*common.Player]*velocityEstimate),
 		grenadeProjectiles:       make(map[int]*common.GrenadeProjectile),
 		infernos:                 make(map[int]*common.Inferno),
+		smokes:                   make(map[int]*common.Smoke),
 		weapons:                  make(map[int]*common.Equipment),
 		hostages:                 make(map[int]*common.Hostage),
 		entities:                 make(map[int]st.Entity),
@@ -313,8 +359,10 @@ func (gr gameRules) Entity() st.Entity {
 //
 // See GameState.Participants()
 type participants struct {
-	playersByUserID   map[int]*common.Player // Maps user-IDs to players
-	playersByEntityID map[int]*common.Player // Maps entity-IDs to players
+	playersByUserID    map[int]*common.Player            // Maps user-IDs to players
+	playersByEntityID  map[int]*common.Player            // Maps entity-IDs to players
+	playersBySteamID32 map[uint32]*common.Player         // Maps 32-bit-steam-IDs to players
+	playerIdentities   map[uint64]*common.PlayerIdentity // Maps SteamID64s to identities of human players
 }
 
 // ByUserID returns all currently connected players in a map where the key is the user-ID.
@@ -359,6 +407,32 @@ func (ptcp participants) AllByUserID() map[int]*common.Player {
 	return res
 }
 
+// BySteamID64 returns all currently known human players & spectators, including disconnected ones,
+// in a map where the key is the SteamID64.
+// Unlike AllByUserID() this contains every person only once, with the Player instance of their latest connection.
+// The returned map is a snapshot and is not updated on changes (not a reference to the actual, underlying map).
+func (ptcp participants) BySteamID64() map[uint64]*common.Player {
+	res := make(map[uint64]*common.Player, len(ptcp.playersBySteamID32))
+	for _, v := range ptcp.playersBySteamID32 {
+		res[v.SteamID64] = v
+	}
+
+	return res
+}
+
+// Identities returns the identities of all human players that connected during the demo,
+// in a map where the key is the SteamID64.
+// Identities stay the same across reconnects and contain the player's connection and bot control intervals.
+// The returned map is a snapshot, but the identities themselves are updated.
+func (ptcp participants) Identities() map[uint64]*common.PlayerIdentity {
+	res := make(map[uint64]*common.PlayerIdentity, len(ptcp.playerIdentities))
+	for k, v := range ptcp.playerIdentities {
+		res[k] = v
+	}
+
+	return res
+}
+
 // All returns all currently known players & spectators, including disconnected ones, of the demo.
 // The returned slice is a snapshot and is not updated on changes.
 func (ptcp participants) All() []*common.Player {
@@ -381,12 +455,12 @@ func (ptcp participants) Connected() []*common.Player {
 	return res
 }
 
-// Playing returns all players that aren't spectating or unassigned.
+// Playing returns all players that aren't spectating, unassigned or coaching, see Player.Role().
 // The returned slice is a snapshot and is not updated on changes.
 func (ptcp participants) Playing() []*common.Player {
 	res, original := ptcp.initializeSliceFromByUserID()
 	for _, p := range original {
-		if p.Team != common.TeamSpectators && p.Team != common.TeamUnassigned {
+		if role := p.Role(); role == common.PlayerRolePlayer || role == common.PlayerRoleBot {
 			res = append(res, p)
 		}
 	}
@@ -394,12 +468,12 @@ func (ptcp participants) Playing() []*common.Player {
 	return res
 }
 
-// TeamMembers returns all players belonging to the requested team at this time.
+// TeamMembers returns all players belonging to the requested team at this time, excluding coaches.
 // The returned slice is a snapshot and is not updated on changes.
 func (ptcp participants) TeamMembers(team common.Team) []*common.Player {
 	res, original := ptcp.initializeSliceFromByUserID()
 	for _, p := range original {
-		if p.Team == team {
+		if p.Team == team && !p.IsCoach() {
 			res = append(res, p)
 		}
 	}
@@ -3,6 +3,8 @@
 package demoinfocs
 
 import (
+	r3 "github.com/golang/geo/r3"
+
 	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
 	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
 )
@@ -42,6 +44,12 @@ type GameState interface {
 	GrenadeProjectiles() map[int]*common.GrenadeProjectile
 	// Infernos returns a map from entity-IDs to all currently burning infernos (fires from incendiaries and Molotovs).
 	Infernos() map[int]*common.Inferno
+	// Smokes returns a map from entity-IDs to all currently active smoke clouds.
+	// The entity-ID is the one of the smoke grenade projectile.
+	Smokes() map[int]*common.Smoke
+	// LineIntersectsSmoke returns true if the line segment between a and b passes through any active smoke cloud.
+	// See common.Smoke for the limitations of the smoke model.
+	LineIntersectsSmoke(a, b r3.Vector) bool
 	// Weapons returns a map from entity-IDs to all weapons currently in the game.
 	Weapons() map[int]*common.Equipment
 	// Entities returns all currently existing entities.
@@ -49,6 +57,9 @@ type GameState interface {
 	Entities() map[int]st.Entity
 	// Bomb returns the current bomb state.
 	Bomb() *common.Bomb
+	// ActiveVote returns the vote that is currently in progress (e.g. a kick, surrender or timeout vote).
+	// Returns nil if no vote is in progress.
+	ActiveVote() *common.Vote
 	// TotalRoundsPlayed returns the amount of total rounds played according to CCSGameRulesProxy.
 	TotalRoundsPlayed() int
 	// GamePhase returns the game phase of the current game state. See common/gamerules.go for more.
@@ -4,10 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/geo/r3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 
 	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/constants"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
 	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
 	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
 )
@@ -84,6 +88,20 @@ func TestParticipants_All(t *testing.T) {
 	assert.ElementsMatch(t, []*common.Player{pl}, allPlayers)
 }
 
+func TestParticipants_BySteamID64(t *testing.T) {
+	pl := newPlayer()
+	pl.SteamID64 = 76561198000000001
+	reconnected := newPlayer()
+	reconnected.SteamID64 = pl.SteamID64
+
+	ptcps := participants{
+		playersByUserID:    map[int]*common.Player{0: pl, 1: reconnected},
+		playersBySteamID32: map[uint32]*common.Player{common.ConvertSteamID64To32(pl.SteamID64): reconnected},
+	}
+
+	assert.Equal(t, map[uint64]*common.Player{pl.SteamID64: reconnected}, ptcps.BySteamID64())
+}
+
 func TestParticipants_Playing(t *testing.T) {
 	terrorist := newPlayer()
 	terrorist.Team = common.TeamTerrorists
@@ -137,6 +155,26 @@ func TestParticipants_TeamMembers(t *testing.T) {
 	assert.ElementsMatch(t, []*common.Player{ct}, cts)
 }
 
+func TestParticipants_ExcludesCoaches(t *testing.T) {
+	ct := newPlayer()
+	ct.Team = common.TeamCounterTerrorists
+
+	coachEntity := new(stfake.Entity)
+	coachEntity.On("PropertyValue", "m_iCoachingTeam").Return(st.PropertyValue{Any: uint32(common.TeamCounterTerrorists)}, true)
+
+	coach := common.NewPlayer(nil)
+	coach.Entity = coachEntity
+	coach.IsConnected = true
+	coach.Team = common.TeamCounterTerrorists
+
+	ptcps := participants{
+		playersByUserID: map[int]*common.Player{0: ct, 1: coach},
+	}
+
+	assert.ElementsMatch(t, []*common.Player{ct}, ptcps.Playing())
+	assert.ElementsMatch(t, []*common.Player{ct}, ptcps.TeamMembers(common.TeamCounterTerrorists))
+}
+
 func TestParticipants_FindByHandle(t *testing.T) {
 	pl := newPlayer()
 	pl.Team = common.TeamTerrorists
@@ -275,6 +313,83 @@ func TestGameRules_IsFreezetimePeriod(t *testing.T) {
 	assert.Equal(t, true, gs.IsFreezetimePeriod())
 }
 
+func TestGameState_ActiveVote(t *testing.T) {
+	p := newParser()
+	issuer := newPlayerWithEntityID(2)
+	target := newPlayerWithEntityID(5)
+	p.gameState.playersByEntityID[2] = issuer
+	p.gameState.playersByEntityID[5] = target
+	p.gameState.ingameTick = 1000
+
+	voteController := new(stfake.Entity)
+	voteController.On("PropertyValue", "m_nVoteOptionCount.0000").Return(st.PropertyValue{Any: int32(3)}, true)
+	voteController.On("PropertyValue", "m_nVoteOptionCount.0001").Return(st.PropertyValue{Any: int32(1)}, true)
+	voteController.On("PropertyValue", "m_nPotentialVotes").Return(st.PropertyValue{Any: int32(5)}, true)
+	p.gameState.voteController = voteController
+
+	var started events.VoteStarted
+	p.RegisterEventHandler(func(e events.VoteStarted) {
+		started = e
+	})
+
+	var ended []events.VoteEnded
+	p.RegisterEventHandler(func(e events.VoteEnded) {
+		ended = append(ended, e)
+	})
+
+	assert.Nil(t, p.GameState().ActiveVote())
+
+	p.handleVoteStart(&msg.CCSUsrMsg_VoteStart{
+		Team:             proto.Int32(-1),
+		PlayerSlot:       proto.Int32(1),
+		VoteType:         proto.Int32(int32(common.VoteIssueKick)),
+		DispStr:          proto.String("#SFUI_vote_kick_player_other"),
+		DetailsStr:       proto.String("target"),
+		IsYesNoVote:      proto.Bool(true),
+		PlayerSlotTarget: proto.Int32(4),
+	})
+
+	vote := p.GameState().ActiveVote()
+	expected := &common.Vote{
+		Issue:          common.VoteIssueKick,
+		DisplayString:  "#SFUI_vote_kick_player_other",
+		Details:        "target",
+		Issuer:         issuer,
+		Target:         target,
+		Team:           common.TeamUnassigned,
+		IsYesNoVote:    true,
+		StartTick:      1000,
+		YesVotes:       3,
+		NoVotes:        1,
+		PotentialVotes: 5,
+	}
+
+	assert.Equal(t, expected, vote)
+	assert.Equal(t, events.VoteStarted{
+		Vote:   vote,
+		Issuer: issuer,
+		Issue:  common.VoteIssueKick,
+		Target: target,
+		Team:   common.TeamUnassigned,
+	}, started)
+
+	p.handleVoteFailed(&msg.CCSUsrMsg_VoteFailed{Reason: proto.Int32(3)})
+
+	// delayed until the end of the frame
+	assert.Empty(t, ended)
+
+	p.processFrameGameEvents()
+
+	assert.Equal(t, []events.VoteEnded{{
+		Vote:   vote,
+		Passed: false,
+		Yes:    3,
+		No:     1,
+		Reason: 3,
+	}}, ended)
+	assert.Nil(t, p.GameState().ActiveVote())
+}
+
 func newPlayer() *common.Player {
 	pl := newPlayerWithEntityID(1)
 	return pl
@@ -306,3 +421,13 @@ func TestGameState_Hostages(t *testing.T) {
 	expectedHostages := []*common.Hostage{hostageA, hostageB}
 	assert.Equal(t, expectedHostages, gs.Hostages())
 }
+
+func TestGameState_LineIntersectsSmoke(t *testing.T) {
+	p := newParser()
+	smoke := common.NewSmoke(p.demoInfoProvider, nil, nil, r3.Vector{X: 1000}, 0)
+	p.gameState.smokes[1] = smoke
+
+	assert.Equal(t, map[int]*common.Smoke{1: smoke}, p.gameState.Smokes())
+	assert.True(t, p.gameState.LineIntersectsSmoke(r3.Vector{}, r3.Vector{X: 2000}))
+	assert.False(t, p.gameState.LineIntersectsSmoke(r3.Vector{}, r3.Vector{Y: 2000}))
+}
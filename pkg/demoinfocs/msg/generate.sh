@@ -18,6 +18,8 @@ protoc -Iproto \
        --go_opt=Musermessages.proto=github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg \
        --go_opt=Mcs_gameevents.proto=github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg \
        --go_opt=Mte.proto=github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg \
+       --go_opt=Musercmd.proto=github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg \
+       --go_opt=Mcs_usercmd.proto=github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg \
        --go_opt=module=github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg \
        cstrike15_gcmessages.proto \
        cstrike15_usermessages.proto \
@@ -31,4 +33,6 @@ protoc -Iproto \
        gameevents.proto \
        usermessages.proto \
        cs_gameevents.proto \
-       te.proto
+       te.proto \
+       usercmd.proto \
+       cs_usercmd.proto
@@ -2,12 +2,17 @@ package demoinfocs
 
 import (
 	"fmt"
+	"slices"
 
+	"github.com/golang/geo/r3"
 	"github.com/markus-wa/go-unassert"
+	"google.golang.org/protobuf/proto"
 
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
 	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sounds"
 )
 
 func (p *parser) onEntity(e sendtables.Entity, op sendtables.EntityOp) error {
@@ -17,9 +22,44 @@ func (p *parser) onEntity(e sendtables.Entity, op sendtables.EntityOp) error {
 		delete(p.gameState.entities, e.ID())
 	}
 
+	if p.config.DispatchEntityEvents {
+		p.dispatchEntityEvent(e, op)
+	}
+
 	return nil
 }
 
+type changedPropertiesProvider interface {
+	ChangedProperties() []string
+}
+
+func (p *parser) dispatchEntityEvent(e sendtables.Entity, op sendtables.EntityOp) {
+	switch {
+	case op&sendtables.EntityOpCreated > 0:
+		p.eventDispatcher.Dispatch(events.EntityCreated{
+			Entity:      e,
+			ServerClass: e.ServerClass(),
+		})
+
+	case op&sendtables.EntityOpDeleted > 0:
+		p.eventDispatcher.Dispatch(events.EntityDestroyed{
+			Entity: e,
+		})
+
+	case op&sendtables.EntityOpUpdated > 0:
+		var changed []string
+
+		if cpp, ok := e.(changedPropertiesProvider); ok {
+			changed = slices.Clone(cpp.ChangedProperties())
+		}
+
+		p.eventDispatcher.Dispatch(events.EntityUpdated{
+			Entity:            e,
+			ChangedProperties: changed,
+		})
+	}
+}
+
 func (p *parser) handleSetConVar(setConVar *msg.CNETMsg_SetConVar) {
 	updated := make(map[string]string)
 	for _, cvar := range setConVar.Convars.Cvars {
@@ -49,6 +89,25 @@ func (p *parser) handleMessageSayText(msg *msg.CUserMessageSayText) {
 		IsChatAll: false,
 		Text:      msg.GetText(),
 	})
+
+	p.eventDispatcher.Dispatch(events.ServerPrint{
+		Source: events.ServerPrintSourceSayText,
+		Text:   msg.GetText(),
+	})
+}
+
+// chatFlagsByMsgName contains the flags of player chat messages (CUserMessageSayText2) by message name.
+var chatFlagsByMsgName = map[string]events.ChatFlags{
+	"Cstrike_Chat_All":     {},
+	"Cstrike_Chat_AllDead": {IsDead: true},
+	"Cstrike_Chat_AllSpec": {IsSpectator: true},
+	"Cstrike_Chat_T":       {IsTeamOnly: true},
+	"Cstrike_Chat_CT":      {IsTeamOnly: true},
+	"Cstrike_Chat_T_Loc":   {IsTeamOnly: true},
+	"Cstrike_Chat_CT_Loc":  {IsTeamOnly: true},
+	"Cstrike_Chat_T_Dead":  {IsTeamOnly: true, IsDead: true},
+	"Cstrike_Chat_CT_Dead": {IsTeamOnly: true, IsDead: true},
+	"Cstrike_Chat_Spec":    {IsTeamOnly: true, IsSpectator: true},
 }
 
 func (p *parser) handleMessageSayText2(msg *msg.CUserMessageSayText2) {
@@ -60,26 +119,25 @@ func (p *parser) handleMessageSayText2(msg *msg.CUserMessageSayText2) {
 		Params:    []string{msg.GetParam1(), msg.GetParam2(), msg.GetParam3(), msg.GetParam4()},
 	})
 
-	switch msg.GetMessagename() {
-	case "Cstrike_Chat_All", "Cstrike_Chat_AllSpec":
-		fallthrough
-	case "Cstrike_Chat_AllDead":
+	if flags, ok := chatFlagsByMsgName[msg.GetMessagename()]; ok {
 		sender := p.gameState.playersByEntityID[int(msg.GetEntityindex())]
+		flags.IsCoach = isCoach(sender)
 
-		p.eventDispatcher.Dispatch(events.ChatMessage{
+		p.dispatchChatMessage(events.ChatMessage{
 			Sender:    sender,
 			Text:      msg.GetParam2(),
-			IsChatAll: false,
+			IsChatAll: !flags.IsTeamOnly,
+			ChatFlags: flags,
 		})
 
+		return
+	}
+
+	switch msg.GetMessagename() {
 	case "#CSGO_Coach_Join_T": // Ignore these
 	case "#CSGO_Coach_Join_CT":
 	case "#CSGO_No_Longer_Coach":
 	case "#Cstrike_Name_Change":
-	case "Cstrike_Chat_T_Loc":
-	case "Cstrike_Chat_CT_Loc":
-	case "Cstrike_Chat_T_Dead":
-	case "Cstrike_Chat_CT_Dead":
 
 	default:
 		errMsg := fmt.Sprintf("skipped sending ChatMessageEvent for SayText2 with unknown MsgName %q", msg.GetMessagename())
@@ -89,6 +147,67 @@ func (p *parser) handleMessageSayText2(msg *msg.CUserMessageSayText2) {
 	}
 }
 
+type chatMessageKey struct {
+	sender *common.Player
+	text   string
+}
+
+// dispatchChatMessage dispatches the message unless the same message has already been dispatched during this frame.
+// Chat messages may be sent both as CUserMessageSayText2 and player_chat game-event.
+func (p *parser) dispatchChatMessage(e events.ChatMessage) {
+	key := chatMessageKey{sender: e.Sender, text: e.Text}
+	if p.chatMessagesThisFrame[key] {
+		return
+	}
+
+	if p.chatMessagesThisFrame == nil {
+		p.chatMessagesThisFrame = make(map[chatMessageKey]bool)
+	}
+
+	p.chatMessagesThisFrame[key] = true
+
+	p.eventDispatcher.Dispatch(e)
+}
+
+// isCoach returns true if the player is coaching a team.
+func isCoach(pl *common.Player) bool {
+	return pl != nil && pl.IsCoach()
+}
+
+func (p *parser) handleRadioText(msg *msg.CCSUsrMsg_RadioText) {
+	pl := p.gameState.playerByClientSlot(msg.GetClient())
+
+	flags := events.ChatFlags{
+		IsTeamOnly: true,
+		IsCoach:    isCoach(pl),
+	}
+
+	if pl != nil {
+		flags.IsSpectator = pl.Team == common.TeamSpectators
+	}
+
+	p.eventDispatcher.Dispatch(events.RadioCommand{
+		Player:    pl,
+		MsgName:   msg.GetMsgName(),
+		Params:    msg.GetParams(),
+		ChatFlags: flags,
+	})
+}
+
+func (p *parser) handleHudMsg(msg *msg.CCSUsrMsg_HudMsg) {
+	p.eventDispatcher.Dispatch(events.ServerPrint{
+		Source: events.ServerPrintSourceHudMsg,
+		Text:   msg.GetText(),
+	})
+}
+
+func (p *parser) handlePrint(msg *msg.CSVCMsg_Print) {
+	p.eventDispatcher.Dispatch(events.ServerPrint{
+		Source: events.ServerPrintSourcePrint,
+		Text:   msg.GetText(),
+	})
+}
+
 func (p *parser) handleServerRankUpdate(msg *msg.CCSUsrMsg_ServerRankUpdate) {
 	for _, v := range msg.RankUpdate {
 		steamID32 := uint32(v.GetAccountId())
@@ -110,3 +229,291 @@ func (p *parser) handleServerRankUpdate(msg *msg.CCSUsrMsg_ServerRankUpdate) {
 		})
 	}
 }
+
+func (p *parser) handleVoteStart(m *msg.CCSUsrMsg_VoteStart) {
+	vote := &common.Vote{
+		Issue:         common.VoteIssue(m.GetVoteType()),
+		DisplayString: m.GetDispStr(),
+		Details:       m.GetDetailsStr(),
+		Issuer:        p.gameState.playerByClientSlot(m.GetPlayerSlot()),
+		Target:        p.gameState.playerByClientSlot(m.GetPlayerSlotTarget()),
+		Team:          voteTeam(m.GetTeam()),
+		IsYesNoVote:   m.GetIsYesNoVote(),
+		StartTick:     p.gameState.ingameTick,
+	}
+
+	p.gameState.activeVote = vote
+	p.updateActiveVoteCounts()
+
+	p.eventDispatcher.Dispatch(events.VoteStarted{
+		Vote:   vote,
+		Issuer: vote.Issuer,
+		Issue:  vote.Issue,
+		Target: vote.Target,
+		Team:   vote.Team,
+	})
+}
+
+// The final vote counts may be sent after the end of the vote, so these are delayed until the end of the frame.
+func (p *parser) handleVotePass(*msg.CCSUsrMsg_VotePass) {
+	p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+		p.endVote(true, 0)
+	})
+}
+
+func (p *parser) handleVoteFailed(m *msg.CCSUsrMsg_VoteFailed) {
+	p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+		p.endVote(false, int(m.GetReason()))
+	})
+}
+
+func (p *parser) endVote(passed bool, reason int) {
+	vote := p.gameState.activeVote
+	p.gameState.activeVote = nil
+
+	event := events.VoteEnded{
+		Vote:   vote,
+		Passed: passed,
+		Reason: reason,
+	}
+
+	if vote != nil {
+		event.Yes = vote.YesVotes
+		event.No = vote.NoVotes
+	}
+
+	p.eventDispatcher.Dispatch(event)
+}
+
+func (p *parser) handleCallVoteFailed(m *msg.CCSUsrMsg_CallVoteFailed) {
+	p.eventDispatcher.Dispatch(events.VoteCallFailed{
+		Reason: int(m.GetReason()),
+		Time:   int(m.GetTime()),
+	})
+}
+
+func (p *parser) handleServerSteamID(m *msg.CSVCMsg_ServerSteamID) {
+	p.matchInfo.ServerSteamID = m.GetSteamId()
+}
+
+// handleRoundBackupFilenames stores the announced round backups by index,
+// the server re-sends the whole list whenever a new backup is created.
+func (p *parser) handleRoundBackupFilenames(m *msg.CCSUsrMsg_RoundBackupFilenames) {
+	backup := RoundBackup{
+		Index:    int(m.GetIndex()),
+		Filename: m.GetFilename(),
+		NiceName: m.GetNicename(),
+	}
+
+	for i, b := range p.matchInfo.RoundBackups {
+		if b.Index == backup.Index {
+			p.matchInfo.RoundBackups[i] = backup
+
+			return
+		}
+	}
+
+	p.matchInfo.RoundBackups = append(p.matchInfo.RoundBackups, backup)
+
+	slices.SortFunc(p.matchInfo.RoundBackups, func(a, b RoundBackup) int {
+		return a.Index - b.Index
+	})
+}
+
+// voteTeam converts the team of vote messages, which is -1 if everyone can vote.
+func voteTeam(team int32) common.Team {
+	if team < 0 {
+		return common.TeamUnassigned
+	}
+
+	return common.Team(team)
+}
+
+// soundLevelGunfire is the sound level (SNDLVL_GUNFIRE) of weapon sounds, which aren't included in CCSUsrMsg_WeaponSound.
+const soundLevelGunfire = 140
+
+// soundRadius returns the approximate distance at which a sound with the given sound level (in dB) is audible.
+// Sounds fade out at a distance of 1000 / attenuation, where attenuation is 20 / (level - 50) (4 for levels <= 50).
+func soundRadius(level int32) float64 {
+	if level <= 0 {
+		return 0
+	}
+
+	attenuation := 4.0
+	if level > 50 {
+		attenuation = 20 / float64(level-50)
+	}
+
+	return 1000 / attenuation
+}
+
+// soundEntity returns the entity with the given index and the player that it belongs to, either may be nil.
+func (p *parser) soundEntity(index int32) (sendtables.Entity, *common.Player) {
+	entity := p.gameState.entities[int(index)]
+	if entity == nil {
+		return nil, nil
+	}
+
+	if pl := p.gameState.playersByEntityID[entity.ID()]; pl != nil {
+		return entity, pl
+	}
+
+	if wep := p.gameState.weapons[entity.ID()]; wep != nil {
+		return entity, wep.Owner
+	}
+
+	if controller, ok := entity.PropertyValue("m_hController"); ok && controller.Any != nil {
+		return entity, p.gameState.Participants().FindByHandle64(controller.Handle())
+	}
+
+	return entity, nil
+}
+
+func (p *parser) handleSounds(m *msg.CSVCMsg_Sounds) {
+	for _, sound := range m.GetSounds() {
+		entity, pl := p.soundEntity(sound.GetEntityIndex())
+
+		hash := sound.GetSoundNumHandle()
+		if hash == 0 {
+			hash = sound.GetSoundNum()
+		}
+
+		origin := r3.Vector{
+			X: float64(sound.GetOriginX()),
+			Y: float64(sound.GetOriginY()),
+			Z: float64(sound.GetOriginZ()),
+		}
+
+		if sound.OriginX == nil && sound.OriginY == nil && sound.OriginZ == nil && entity != nil {
+			origin = entity.Position()
+		}
+
+		name, _ := sounds.Name(hash)
+
+		p.eventDispatcher.Dispatch(events.SoundPlayed{
+			Source:    events.SoundSourceSounds,
+			Origin:    origin,
+			SoundHash: hash,
+			SoundName: name,
+			Entity:    entity,
+			Player:    pl,
+			Volume:    int(sound.GetVolume()),
+			Radius:    soundRadius(sound.GetSoundLevel()),
+		})
+	}
+}
+
+func (p *parser) handleWeaponSound(m *msg.CCSUsrMsg_WeaponSound) {
+	entity, pl := p.soundEntity(m.GetEntidx())
+
+	p.eventDispatcher.Dispatch(events.SoundPlayed{
+		Source: events.SoundSourceWeaponSound,
+		Origin: r3.Vector{
+			X: float64(m.GetOriginX()),
+			Y: float64(m.GetOriginY()),
+			Z: float64(m.GetOriginZ()),
+		},
+		SoundHash: sounds.Hash(m.GetSound()),
+		SoundName: m.GetSound(),
+		Entity:    entity,
+		Player:    pl,
+		Radius:    soundRadius(soundLevelGunfire),
+	})
+}
+
+func (p *parser) handleSosStartSoundEvent(m *msg.CMsgSosStartSoundEvent) {
+	entity, pl := p.soundEntity(m.GetSourceEntityIndex())
+
+	var origin r3.Vector
+	if entity != nil {
+		origin = entity.Position()
+	}
+
+	name, _ := sounds.Name(m.GetSoundeventHash())
+	level, _ := sounds.Level(m.GetSoundeventHash()) // sound events don't contain their sound level
+
+	p.eventDispatcher.Dispatch(events.SoundPlayed{
+		Source:    events.SoundSourceSoundEvent,
+		Origin:    origin,
+		SoundHash: m.GetSoundeventHash(),
+		SoundName: name,
+		Entity:    entity,
+		Player:    pl,
+		Radius:    soundRadius(level),
+	})
+}
+
+func (p *parser) handleDemoUserCmd(m *msg.CDemoUserCmd) {
+	p.dispatchUserCommand(m.GetData(), int(m.GetCmdNumber()), int32(p.recordingPlayerSlot), p.gameState.ingameTick)
+}
+
+func (p *parser) handleServerUserCommands(m *msg.CSVCMsg_UserCommands) {
+	for _, cmd := range m.GetCommands() {
+		tick := int(cmd.GetServerTickExecuted())
+		if tick <= 0 {
+			tick = p.gameState.ingameTick
+		}
+
+		p.dispatchUserCommand(cmd.GetData(), int(cmd.GetCmdNumber()), cmd.GetPlayerSlot(), tick)
+	}
+}
+
+// dispatchUserCommand decodes the CSGOUserCmdPB payload of CDemoUserCmd / CMsgServerUserCmd and dispatches a UserCommand event.
+// The player is looked up via the pawn handle of the command if available, otherwise via the client slot.
+func (p *parser) dispatchUserCommand(data []byte, cmdNumber int, playerSlot int32, tick int) {
+	cmd := new(msg.CSGOUserCmdPB)
+
+	err := proto.Unmarshal(data, cmd)
+	if err != nil {
+		p.eventDispatcher.Dispatch(events.ParserWarn{
+			Message: fmt.Sprintf("failed to decode user command: %v", err),
+			Type:    events.WarnTypeUserCommandDecodingFailure,
+		})
+
+		return
+	}
+
+	base := cmd.GetBase()
+
+	pl := p.gameState.Participants().FindByPawnHandle(uint64(base.GetPawnEntityHandle()))
+	if pl == nil {
+		pl = p.gameState.playerByClientSlot(playerSlot)
+	}
+
+	var weapon *common.Equipment
+	if sel := base.GetWeaponselect(); sel > 0 {
+		weapon = p.gameState.weapons[int(sel)]
+	}
+
+	subtickMoves := make([]events.SubtickMove, 0, len(base.GetSubtickMoves()))
+
+	for _, move := range base.GetSubtickMoves() {
+		subtickMoves = append(subtickMoves, events.SubtickMove{
+			Button:             events.UserCmdButtons(move.GetButton()),
+			Pressed:            move.GetPressed(),
+			When:               move.GetWhen(),
+			AnalogForwardDelta: move.GetAnalogForwardDelta(),
+			AnalogLeftDelta:    move.GetAnalogLeftDelta(),
+			PitchDelta:         move.GetPitchDelta(),
+			YawDelta:           move.GetYawDelta(),
+		})
+	}
+
+	p.eventDispatcher.Dispatch(events.UserCommand{
+		Player:        pl,
+		Tick:          tick,
+		ClientTick:    int(base.GetClientTick()),
+		CommandNumber: cmdNumber,
+		ViewAngles: r3.Vector{
+			X: float64(base.GetViewangles().GetX()),
+			Y: float64(base.GetViewangles().GetY()),
+			Z: float64(base.GetViewangles().GetZ()),
+		},
+		Buttons:      events.UserCmdButtons(base.GetButtonsPb().GetButtonstate1()),
+		ForwardMove:  base.GetForwardmove(),
+		SideMove:     base.GetLeftmove(),
+		SubtickMoves: subtickMoves,
+		Weapon:       weapon,
+		Command:      cmd,
+	})
+}
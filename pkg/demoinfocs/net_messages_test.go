@@ -0,0 +1,220 @@
+package demoinfocs
+
+import (
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sounds"
+)
+
+func TestSoundRadius(t *testing.T) {
+	assert.Zero(t, soundRadius(0))
+	assert.Equal(t, 250.0, soundRadius(40))
+	assert.Equal(t, 1250.0, soundRadius(75))
+	assert.Equal(t, 4500.0, soundRadius(soundLevelGunfire))
+}
+
+func TestHandleSounds(t *testing.T) {
+	p := newParser()
+	pl := newPlayerWithEntityID(3)
+	p.gameState.playersByEntityID[3] = pl
+	p.gameState.entities[3] = pl.Entity
+
+	var played []events.SoundPlayed
+	p.RegisterEventHandler(func(e events.SoundPlayed) {
+		played = append(played, e)
+	})
+
+	p.handleSounds(&msg.CSVCMsg_Sounds{
+		Sounds: []*msg.CSVCMsg_SoundsSounddataT{{
+			OriginX:        proto.Int32(10),
+			OriginY:        proto.Int32(20),
+			OriginZ:        proto.Int32(30),
+			Volume:         proto.Uint32(100),
+			EntityIndex:    proto.Int32(3),
+			SoundNumHandle: proto.Uint32(sounds.Hash("Weapon_AK47.Single")),
+			SoundLevel:     proto.Int32(75),
+		}},
+	})
+
+	p.handleWeaponSound(&msg.CCSUsrMsg_WeaponSound{
+		Entidx:  proto.Int32(42),
+		OriginX: proto.Float32(1),
+		Sound:   proto.String("Weapon_AWP.Single"),
+	})
+
+	assert.Equal(t, []events.SoundPlayed{
+		{
+			Source:    events.SoundSourceSounds,
+			Origin:    r3.Vector{X: 10, Y: 20, Z: 30},
+			SoundHash: sounds.Hash("Weapon_AK47.Single"),
+			SoundName: "Weapon_AK47.Single",
+			Entity:    pl.Entity,
+			Player:    pl,
+			Volume:    100,
+			Radius:    1250,
+		},
+		{
+			Source:    events.SoundSourceWeaponSound,
+			Origin:    r3.Vector{X: 1},
+			SoundHash: sounds.Hash("Weapon_AWP.Single"),
+			SoundName: "Weapon_AWP.Single",
+			Radius:    4500,
+		},
+	}, played)
+}
+
+func TestHandleSosStartSoundEvent(t *testing.T) {
+	p := newParser()
+	pl := newPlayerWithEntityID(3)
+	pl.Entity.(*stfake.Entity).On("Position").Return(r3.Vector{X: 10, Y: 20, Z: 30})
+	p.gameState.playersByEntityID[3] = pl
+	p.gameState.entities[3] = pl.Entity
+
+	var played []events.SoundPlayed
+	p.RegisterEventHandler(func(e events.SoundPlayed) {
+		played = append(played, e)
+	})
+
+	p.handleSosStartSoundEvent(&msg.CMsgSosStartSoundEvent{
+		SoundeventHash:    proto.Uint32(sounds.Hash("Weapon_AK47.Single")),
+		SourceEntityIndex: proto.Int32(3),
+	})
+	p.handleSosStartSoundEvent(&msg.CMsgSosStartSoundEvent{
+		SoundeventHash: proto.Uint32(sounds.Hash("Test.Unknown")),
+	})
+
+	assert.Equal(t, []events.SoundPlayed{
+		{
+			Source:    events.SoundSourceSoundEvent,
+			Origin:    r3.Vector{X: 10, Y: 20, Z: 30},
+			SoundHash: sounds.Hash("Weapon_AK47.Single"),
+			SoundName: "Weapon_AK47.Single",
+			Entity:    pl.Entity,
+			Player:    pl,
+			Radius:    4500,
+		},
+		{
+			Source:    events.SoundSourceSoundEvent,
+			SoundHash: sounds.Hash("Test.Unknown"),
+			Radius:    soundRadius(sounds.DefaultLevel),
+		},
+	}, played)
+}
+
+func TestHandleServerUserCommands(t *testing.T) {
+	p := newParser()
+	pl := newPlayerWithEntityID(3)
+	p.gameState.playersByEntityID[3] = pl
+
+	var (
+		cmds  []events.UserCommand
+		warns []events.ParserWarn
+	)
+
+	p.RegisterEventHandler(func(e events.UserCommand) {
+		cmds = append(cmds, e)
+	})
+	p.RegisterEventHandler(func(e events.ParserWarn) {
+		warns = append(warns, e)
+	})
+
+	data, err := proto.Marshal(&msg.CSGOUserCmdPB{
+		Base: &msg.CBaseUserCmdPB{
+			ClientTick: proto.Int32(1000),
+			ButtonsPb: &msg.CInButtonStatePB{
+				Buttonstate1: proto.Uint64(uint64(events.UserCmdButtonAttack | events.UserCmdButtonDuck)),
+			},
+			Viewangles:  &msg.CMsgQAngle{X: proto.Float32(10), Y: proto.Float32(90)},
+			Forwardmove: proto.Float32(1),
+			Leftmove:    proto.Float32(-1),
+			SubtickMoves: []*msg.CSubtickMoveStep{{
+				Button:  proto.Uint64(uint64(events.UserCmdButtonAttack)),
+				Pressed: proto.Bool(true),
+				When:    proto.Float32(0.25),
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	p.handleServerUserCommands(&msg.CSVCMsg_UserCommands{
+		Commands: []*msg.CMsgServerUserCmd{
+			{
+				Data:               data,
+				CmdNumber:          proto.Int32(5),
+				PlayerSlot:         proto.Int32(2),
+				ServerTickExecuted: proto.Int32(1002),
+			},
+			{
+				Data: []byte{0xff},
+			},
+		},
+	})
+
+	assert.Len(t, cmds, 1)
+	assert.Equal(t, pl, cmds[0].Player)
+	assert.Equal(t, 1002, cmds[0].Tick)
+	assert.Equal(t, 1000, cmds[0].ClientTick)
+	assert.Equal(t, 5, cmds[0].CommandNumber)
+	assert.Equal(t, r3.Vector{X: 10, Y: 90}, cmds[0].ViewAngles)
+	assert.True(t, cmds[0].Buttons.Has(events.UserCmdButtonAttack|events.UserCmdButtonDuck))
+	assert.False(t, cmds[0].Buttons.Has(events.UserCmdButtonJump))
+	assert.Equal(t, float32(1), cmds[0].ForwardMove)
+	assert.Equal(t, float32(-1), cmds[0].SideMove)
+	assert.Equal(t, []events.SubtickMove{{
+		Button:  events.UserCmdButtonAttack,
+		Pressed: true,
+		When:    0.25,
+	}}, cmds[0].SubtickMoves)
+	assert.Nil(t, cmds[0].Weapon)
+
+	assert.Len(t, warns, 1)
+	assert.Equal(t, events.WarnType(events.WarnTypeUserCommandDecodingFailure), warns[0].Type)
+}
+
+func TestMatchInfo(t *testing.T) {
+	p := newParser()
+
+	p.handleServerSteamID(&msg.CSVCMsg_ServerSteamID{SteamId: proto.Uint64(90000000000000001)})
+	p.handleRoundBackupFilenames(&msg.CCSUsrMsg_RoundBackupFilenames{
+		Count:    proto.Int32(2),
+		Index:    proto.Int32(1),
+		Filename: proto.String("backup_round01.txt"),
+		Nicename: proto.String("round 1"),
+	})
+	p.handleRoundBackupFilenames(&msg.CCSUsrMsg_RoundBackupFilenames{
+		Count:    proto.Int32(2),
+		Index:    proto.Int32(0),
+		Filename: proto.String("backup_round00.txt"),
+	})
+	p.handleRoundBackupFilenames(&msg.CCSUsrMsg_RoundBackupFilenames{
+		Count:    proto.Int32(2),
+		Index:    proto.Int32(1),
+		Filename: proto.String("backup_round01.txt"),
+		Nicename: proto.String("round 1 (updated)"),
+	})
+
+	gameInfo := &msg.CGameInfo_CCSGameInfo{RoundStartTicks: []int32{100, 5000}}
+	p.handleFileInfo(&msg.CDemoFileInfo{
+		PlaybackTime:   proto.Float32(60),
+		PlaybackTicks:  proto.Int32(3840),
+		PlaybackFrames: proto.Int32(3840),
+		GameInfo:       &msg.CGameInfo{Cs: gameInfo},
+	})
+
+	assert.Equal(t, MatchInfo{
+		ServerSteamID:   90000000000000001,
+		RoundStartTicks: []int{100, 5000},
+		RoundBackups: []RoundBackup{
+			{Index: 0, Filename: "backup_round00.txt"},
+			{Index: 1, Filename: "backup_round01.txt", NiceName: "round 1 (updated)"},
+		},
+		GameInfo: gameInfo,
+	}, p.MatchInfo())
+}
@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"runtime/debug"
+	"slices"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,7 +26,7 @@ import (
 	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
 )
 
-//go:generate ifacemaker -f parser.go -f parsing.go -s parser -i Parser -p demoinfocs -D -y "Parser is an auto-generated interface for Parser, intended to be used when mockability is needed." -c "DO NOT EDIT: Auto generated" -o parser_interface.go
+//go:generate ifacemaker -f parser.go -f parsing.go -f snapshot.go -s parser -i Parser -p demoinfocs -D -y "Parser is an auto-generated interface for Parser, intended to be used when mockability is needed." -c "DO NOT EDIT: Auto generated" -o parser_interface.go
 
 type sendTableParser interface {
 	ReadEnterPVS(r *bit.BitReader, index int, entities map[int]st.Entity, slot int) st.Entity
@@ -33,12 +37,15 @@ type sendTableParser interface {
 	OnServerInfo(m *msg.CSVCMsg_ServerInfo) error
 	OnPacketEntities(m *msg.CSVCMsg_PacketEntities) error
 	OnEntity(h st.EntityHandler)
+	OnPropertyChange(classPattern, propPattern string, handler st.PropertyChangeHandler) error
+	SetGameBuild(build uint32)
 }
 
 // header contains information from a demo's header.
 type header struct {
 	Filestamp       string        // aka. File-type, must be HL2DEMO
 	NetworkProtocol int           // Not sure what this is for
+	BuildNum        int           // Game build the demo was recorded with
 	ServerName      string        // Server's 'hostname' config value
 	ClientName      string        // Usually 'GOTV Demo'
 	MapName         string        // E.g. de_cache, de_nuke, cs_office, etc.
@@ -119,6 +126,7 @@ type parser struct {
 	 */
 	recordingPlayerSlot           int
 	disableMimicSource1GameEvents bool
+	propertyChangeSubscriptions   []propertyChangeSubscription // Subscriptions registered before the sendtables parser was created
 
 	// Additional fields, mainly caching & tracking things
 
@@ -134,6 +142,12 @@ type parser struct {
 	stringTables          []*msg.CSVCMsg_CreateStringTable                         // Contains all created sendtables, needed when updating them
 	delayedEventHandlers  []func()                                                 // Contains event handlers that need to be executed at the end of a tick (e.g. flash events because FlashDuration isn't updated before that)
 	pendingMessagesCache  []pendingMessage                                         // Cache for pending messages that need to be dispatched after the current tick
+	entitySnapshotCache   map[int]entitySnapshotCacheEntry                         // Entity snapshots of the previous Snapshot() call, shared with the next snapshot if unchanged
+	chatMessagesThisFrame map[chatMessageKey]bool                                  // Chat messages dispatched during the current frame, used to skip duplicates from player_chat game-events
+	shotTraces            shotTraceTracker                                         // Shots, hits and bullet temp-entities of the current frame, see ShotTrace
+	matchInfo             MatchInfo                                                // Match and server metadata, see Parser.MatchInfo()
+	disconnectReasons     map[uint64]msg.ENetworkDisconnectionReason               // Reasons of player_disconnect game-events by SteamID64, until PlayerDisconnected is dispatched or the player connects again
+	observerTargets       map[*common.Player]*common.Player                        // Observer targets of the previous frame, see events.ObserverTargetChanged
 }
 
 // NetMessageCreator creates additional net-messages to be dispatched to net-message handlers.
@@ -151,6 +165,12 @@ type boundingBoxInformation struct {
 	max r3.Vector
 }
 
+type propertyChangeSubscription struct {
+	classPattern string
+	propPattern  string
+	handler      st.PropertyChangeHandler
+}
+
 func (bbi boundingBoxInformation) contains(point r3.Vector) bool {
 	return point.X >= bbi.min.X && point.X <= bbi.max.X &&
 		point.Y >= bbi.min.Y && point.Y <= bbi.max.Y &&
@@ -163,6 +183,109 @@ func (p *parser) ServerClasses() st.ServerClasses {
 	return p.stParser.ServerClasses()
 }
 
+// GameEventDescriptor describes a game-event that is defined by the demo.
+type GameEventDescriptor struct {
+	ID     int
+	Name   string
+	Keys   []GameEventKeyDescriptor
+	Mapped bool // true if the parser translates the event into a specific event type (besides events.GenericGameEvent)
+}
+
+// GameEventKeyDescriptor describes a key of a game-event.
+type GameEventKeyDescriptor struct {
+	Name string
+	Type events.GameEventKeyType
+}
+
+// GameEventDescriptors returns all game-events that are defined by this demo, sorted by ID.
+// This includes events that aren't handled by the parser and are only dispatched as events.GenericGameEvent.
+// Returns nil if the game-event list hasn't been parsed yet.
+func (p *parser) GameEventDescriptors() []GameEventDescriptor {
+	if p.gameEventDescs == nil {
+		return nil
+	}
+
+	descs := make([]GameEventDescriptor, 0, len(p.gameEventDescs))
+
+	for _, d := range p.gameEventDescs {
+		desc := GameEventDescriptor{
+			ID:     int(d.GetEventid()),
+			Name:   d.GetName(),
+			Mapped: p.gameEventHandler.gameEventNameToHandler[d.GetName()] != nil,
+		}
+
+		for _, k := range d.GetKeys() {
+			desc.Keys = append(desc.Keys, GameEventKeyDescriptor{
+				Name: k.GetName(),
+				Type: events.GameEventKeyType(k.GetType()),
+			})
+		}
+
+		descs = append(descs, desc)
+	}
+
+	sort.Slice(descs, func(i, j int) bool {
+		return descs[i].ID < descs[j].ID
+	})
+
+	return descs
+}
+
+// RoundBackup is a round backup file announced by the server, see CCSUsrMsg_RoundBackupFilenames.
+type RoundBackup struct {
+	Index    int
+	Filename string // E.g. 'backup_round03.txt'
+	NiceName string // Human readable description of the backup
+}
+
+// MatchInfo contains metadata about the match and the server it was played on.
+//
+// Note that CCSGameInfo of the current demo format only contains the round start ticks,
+// there is no match ID, server IP hash or per-round summary in CS2 demos.
+type MatchInfo struct {
+	ServerSteamID   uint64                     // Steam ID of the server from CSVCMsg_ServerSteamID, 0 if unknown
+	RoundStartTicks []int                      // Ingame ticks at which the rounds started, from CDemoFileInfo
+	RoundBackups    []RoundBackup              // Round backups the server announced, ordered by index
+	GameInfo        *msg.CGameInfo_CCSGameInfo // Raw game info from CDemoFileInfo, nil if not received (yet)
+}
+
+// MatchInfo returns metadata about the match and the server it was played on.
+// The game info of CDemoFileInfo is usually only available after the demo has been parsed to the end.
+func (p *parser) MatchInfo() MatchInfo {
+	return p.matchInfo
+}
+
+// OnPropertyChange registers a handler that is called whenever a property of an entity changes,
+// where the server-class name matches classPattern and the property name matches propPattern.
+// Patterns use the syntax of path.Match(), e.g. OnPropertyChange("CCSPlayerPawn", "m_pWeaponServices.m_hMyWeapons.*", handler).
+// The handler receives the entity, the full property name and the old and new value.
+//
+// Matching is done once per server-class and property, so this is a lot cheaper than
+// registering handlers via Entity.Property().OnUpdate() for every entity.
+//
+// Returns path.ErrBadPattern if one of the patterns is malformed.
+func (p *parser) OnPropertyChange(classPattern, propPattern string, handler st.PropertyChangeHandler) error {
+	if _, err := path.Match(classPattern, ""); err != nil {
+		return err
+	}
+
+	if _, err := path.Match(propPattern, ""); err != nil {
+		return err
+	}
+
+	if p.stParser != nil {
+		return p.stParser.OnPropertyChange(classPattern, propPattern, handler)
+	}
+
+	p.propertyChangeSubscriptions = append(p.propertyChangeSubscriptions, propertyChangeSubscription{
+		classPattern: classPattern,
+		propPattern:  propPattern,
+		handler:      handler,
+	})
+
+	return nil
+}
+
 // GameState returns the current game-state.
 // It contains most of the relevant information about the game such as players, teams, scores, grenades etc.
 func (p *parser) GameState() GameState {
@@ -511,6 +634,60 @@ type ParserConfig struct {
 	// It's the maximum time to retry for a response from the CSTV server, using an exponential backoff mechanism, starting at 1s.
 	// Only used when Format is DemoFormatCSTVBroadcast.
 	CSTVTimeout time.Duration
+
+	// DispatchEntityEvents tells the parser to dispatch events.EntityCreated, events.EntityUpdated and events.EntityDestroyed.
+	// This is disabled by default as it has a performance impact (especially EntityUpdated, which is dispatched a lot).
+	DispatchEntityEvents bool
+
+	// EntitySelection restricts which entities and properties are decoded, which can speed up parsing considerably.
+	// Entities of excluded server-classes are still created and destroyed, but none of their properties are decoded.
+	// Excluded properties are skipped and won't have a value.
+	//
+	// Watch out: the parser itself needs many entities and properties (players, weapons, game rules, teams, grenades etc.)
+	// for GameState() and most events. Excluding them will break these.
+	// Nil (default) decodes all entities and properties.
+	EntitySelection *EntitySelection
+
+	// FieldPatches are applied to the definitions of entity properties (fields) before their decoders are set up.
+	// Can be used to hotfix encoders, bit counts etc. after a game update without waiting for a new release.
+	// Patches are applied after the built-in ones and only to demos with a game build within their build range.
+	FieldPatches []st.FieldPatch
+}
+
+// EntitySelection is a list of server-classes and property-name prefixes to decode or skip.
+// See ParserConfig.EntitySelection.
+type EntitySelection struct {
+	AllowClasses []string // If not empty, only entities of these server-classes are decoded
+	DenyClasses  []string // Entities of these server-classes are not decoded
+
+	AllowPropertyPrefixes []string // If not empty, only properties starting with one of these prefixes are decoded
+	DenyPropertyPrefixes  []string // Properties starting with one of these prefixes are not decoded
+}
+
+func (es *EntitySelection) includesClass(className string) bool {
+	if len(es.AllowClasses) > 0 && !slices.Contains(es.AllowClasses, className) {
+		return false
+	}
+
+	return !slices.Contains(es.DenyClasses, className)
+}
+
+func (es *EntitySelection) includesProperty(_, propName string) bool {
+	if len(es.AllowPropertyPrefixes) > 0 && !hasAnyPrefix(propName, es.AllowPropertyPrefixes) {
+		return false
+	}
+
+	return !hasAnyPrefix(propName, es.DenyPropertyPrefixes)
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // DefaultParserConfig is the default Parser configuration used by NewParser().
@@ -555,6 +732,9 @@ func NewParserWithConfig(demostream io.Reader, config ParserConfig) Parser {
 	p.msgDispatcher = dp.NewDispatcherWithConfig(dispatcherCfg)
 	p.eventDispatcher = dp.NewDispatcherWithConfig(dispatcherCfg)
 
+	p.eventDispatcher.RegisterHandler(p.trackShotTraceWeaponFire)
+	p.eventDispatcher.RegisterHandler(p.trackShotTracePlayerHurt)
+
 	p.msgDispatcher.RegisterHandler(p.handleGameEventList)
 	p.msgDispatcher.RegisterHandler(p.handleGameEvent)
 	p.msgDispatcher.RegisterHandler(p.handleServerInfo)
@@ -564,6 +744,25 @@ func NewParserWithConfig(demostream io.Reader, config ParserConfig) Parser {
 	p.msgDispatcher.RegisterHandler(p.handleServerRankUpdate)
 	p.msgDispatcher.RegisterHandler(p.handleMessageSayText)
 	p.msgDispatcher.RegisterHandler(p.handleMessageSayText2)
+	p.msgDispatcher.RegisterHandler(p.handleRadioText)
+	p.msgDispatcher.RegisterHandler(p.handleHudMsg)
+	p.msgDispatcher.RegisterHandler(p.handlePrint)
+	p.msgDispatcher.RegisterHandler(p.handleSounds)
+	p.msgDispatcher.RegisterHandler(p.handleWeaponSound)
+	p.msgDispatcher.RegisterHandler(p.handleSosStartSoundEvent)
+	p.msgDispatcher.RegisterHandler(p.handleTEImpact)
+	p.msgDispatcher.RegisterHandler(p.handleTEArmorRicochet)
+	p.msgDispatcher.RegisterHandler(p.handleTEBloodStream)
+	p.msgDispatcher.RegisterHandler(p.handleTEEffectDispatch)
+	p.msgDispatcher.RegisterHandler(p.handleTEMuzzleFlash)
+	p.msgDispatcher.RegisterHandler(p.handleDemoUserCmd)
+	p.msgDispatcher.RegisterHandler(p.handleServerUserCommands)
+	p.msgDispatcher.RegisterHandler(p.handleVoteStart)
+	p.msgDispatcher.RegisterHandler(p.handleVotePass)
+	p.msgDispatcher.RegisterHandler(p.handleVoteFailed)
+	p.msgDispatcher.RegisterHandler(p.handleCallVoteFailed)
+	p.msgDispatcher.RegisterHandler(p.handleServerSteamID)
+	p.msgDispatcher.RegisterHandler(p.handleRoundBackupFilenames)
 	p.msgDispatcher.RegisterHandler(p.handleSendTables)
 	p.msgDispatcher.RegisterHandler(p.handleFileInfo)
 	p.msgDispatcher.RegisterHandler(p.handleDemoFileHeader)
@@ -613,3 +812,11 @@ func (p demoInfoProvider) FindEntityByHandle(handle uint64) st.Entity {
 func (p demoInfoProvider) FindWeaponByEntityID(entityID int) *common.Equipment {
 	return p.parser.gameState.weapons[entityID]
 }
+
+func (p demoInfoProvider) EstimatedVelocity(player *common.Player) r3.Vector {
+	if estimate, ok := p.parser.gameState.velocityEstimates[player]; ok {
+		return estimate.velocity
+	}
+
+	return r3.Vector{}
+}
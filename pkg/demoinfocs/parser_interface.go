@@ -35,6 +35,23 @@ type Parser interface {
 	// ServerClasses returns the server-classes of this demo.
 	// These are available after events.DataTablesParsed has been fired.
 	ServerClasses() st.ServerClasses
+	// GameEventDescriptors returns all game-events that are defined by this demo, sorted by ID.
+	// This includes events that aren't handled by the parser and are only dispatched as events.GenericGameEvent.
+	// Returns nil if the game-event list hasn't been parsed yet.
+	GameEventDescriptors() []GameEventDescriptor
+	// MatchInfo returns metadata about the match and the server it was played on.
+	// The game info of CDemoFileInfo is usually only available after the demo has been parsed to the end.
+	MatchInfo() MatchInfo
+	// OnPropertyChange registers a handler that is called whenever a property of an entity changes,
+	// where the server-class name matches classPattern and the property name matches propPattern.
+	// Patterns use the syntax of path.Match(), e.g. OnPropertyChange("CCSPlayerPawn", "m_pWeaponServices.m_hMyWeapons.*", handler).
+	// The handler receives the entity, the full property name and the old and new value.
+	//
+	// Matching is done once per server-class and property, so this is a lot cheaper than
+	// registering handlers via Entity.Property().OnUpdate() for every entity.
+	//
+	// Returns path.ErrBadPattern if one of the patterns is malformed.
+	OnPropertyChange(classPattern, propPattern string, handler st.PropertyChangeHandler) error
 	// GameState returns the current game-state.
 	// It contains most of the relevant information about the game such as players, teams, scores, grenades etc.
 	GameState() GameState
@@ -119,4 +136,10 @@ type Parser interface {
 	   See also: ParseToEnd() for parsing the complete demo in one go (faster).
 	*/
 	ParseNextFrame() (moreFrames bool, err error)
+	// Snapshot returns an immutable snapshot of all entities, players, grenades and the game rules.
+	// Entities that haven't changed since the previous snapshot are shared with it instead of being copied,
+	// which makes it cheap to take a snapshot every few ticks.
+	//
+	// See also: Diff()
+	Snapshot() *Snapshot
 }
@@ -5,11 +5,19 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"path"
 	"testing"
 	"time"
 
+	"github.com/golang/geo/r3"
 	dispatch "github.com/markus-wa/godispatch"
 	"github.com/stretchr/testify/assert"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/constants"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
 )
 
 func TestParser_CurrentFrame(t *testing.T) {
@@ -135,3 +143,259 @@ func TestParser_Close(t *testing.T) {
 
 	assert.False(t, called)
 }
+
+type fakeServerClass struct {
+	st.ServerClass
+}
+
+type entityWithChangedProperties struct {
+	*stfake.Entity
+	changed []string
+}
+
+func (e entityWithChangedProperties) ChangedProperties() []string {
+	return e.changed
+}
+
+func TestParser_OnEntity_DispatchEntityEvents(t *testing.T) {
+	p := newParser()
+	p.config.DispatchEntityEvents = true
+
+	serverClass := fakeServerClass{}
+	entity := entityWithChangedProperties{
+		Entity:  new(stfake.Entity),
+		changed: []string{"m_iHealth", "m_ArmorValue"},
+	}
+	entity.On("ID").Return(1)
+	entity.On("ServerClass").Return(serverClass)
+
+	var dispatched []any
+
+	p.RegisterEventHandler(func(e events.EntityCreated) { dispatched = append(dispatched, e) })
+	p.RegisterEventHandler(func(e events.EntityUpdated) { dispatched = append(dispatched, e) })
+	p.RegisterEventHandler(func(e events.EntityDestroyed) { dispatched = append(dispatched, e) })
+
+	assert.NoError(t, p.onEntity(entity, st.EntityOpCreated|st.EntityOpEntered))
+	assert.NoError(t, p.onEntity(entity, st.EntityOpUpdated))
+	assert.NoError(t, p.onEntity(entity, st.EntityOpDeleted|st.EntityOpLeft))
+
+	assert.Equal(t, []any{
+		events.EntityCreated{Entity: entity, ServerClass: serverClass},
+		events.EntityUpdated{Entity: entity, ChangedProperties: []string{"m_iHealth", "m_ArmorValue"}},
+		events.EntityDestroyed{Entity: entity},
+	}, dispatched)
+}
+
+func TestParser_OnEntity_EntityEventsDisabled(t *testing.T) {
+	p := newParser()
+
+	entity := new(stfake.Entity)
+	entity.On("ID").Return(1)
+
+	p.RegisterEventHandler(func(events.EntityUpdated) { t.Error("EntityUpdated should not be dispatched") })
+
+	assert.NoError(t, p.onEntity(entity, st.EntityOpUpdated))
+}
+
+func TestParser_OnPropertyChange(t *testing.T) {
+	p := newParser()
+
+	err := p.OnPropertyChange("CCSPlayerPawn", "m_pWeaponServices.m_hMyWeapons.*", func(st.Entity, string, st.PropertyValue, st.PropertyValue) {})
+	assert.NoError(t, err)
+	assert.Len(t, p.propertyChangeSubscriptions, 1)
+	assert.Equal(t, "CCSPlayerPawn", p.propertyChangeSubscriptions[0].classPattern)
+	assert.Equal(t, "m_pWeaponServices.m_hMyWeapons.*", p.propertyChangeSubscriptions[0].propPattern)
+}
+
+func TestParser_OnPropertyChange_BadPattern(t *testing.T) {
+	p := newParser()
+
+	err := p.OnPropertyChange("CCSPlayerPawn", "m_iHealth[", func(st.Entity, string, st.PropertyValue, st.PropertyValue) {})
+	assert.ErrorIs(t, err, path.ErrBadPattern)
+	assert.Empty(t, p.propertyChangeSubscriptions)
+}
+
+func TestEntitySelection_IncludesClass(t *testing.T) {
+	sel := &EntitySelection{}
+
+	assert.True(t, sel.includesClass("CCSPlayerPawn"))
+
+	sel.DenyClasses = []string{"CDynamicProp"}
+
+	assert.True(t, sel.includesClass("CCSPlayerPawn"))
+	assert.False(t, sel.includesClass("CDynamicProp"))
+
+	sel.AllowClasses = []string{"CCSPlayerPawn", "CDynamicProp"}
+
+	assert.True(t, sel.includesClass("CCSPlayerPawn"))
+	assert.False(t, sel.includesClass("CDynamicProp"))
+	assert.False(t, sel.includesClass("CCSTeam"))
+}
+
+func TestEntitySelection_IncludesProperty(t *testing.T) {
+	sel := &EntitySelection{}
+
+	assert.True(t, sel.includesProperty("CCSPlayerPawn", "m_iHealth"))
+
+	sel.AllowPropertyPrefixes = []string{"m_i", "m_pWeaponServices."}
+	sel.DenyPropertyPrefixes = []string{"m_iAccount"}
+
+	assert.True(t, sel.includesProperty("CCSPlayerPawn", "m_iHealth"))
+	assert.True(t, sel.includesProperty("CCSPlayerPawn", "m_pWeaponServices.m_hActiveWeapon"))
+	assert.False(t, sel.includesProperty("CCSPlayerPawn", "m_iAccount"))
+	assert.False(t, sel.includesProperty("CCSPlayerPawn", "m_ArmorValue"))
+}
+
+func TestParser_TrackPlayerPositions(t *testing.T) {
+	p := newParser()
+	p.tickInterval = 1.0 / 64
+
+	pawn := new(stfake.Entity)
+	pawn.On("Position").Return(r3.Vector{X: 100}).Once()
+	pawn.On("Position").Return(r3.Vector{X: 108, Y: -4})
+	p.gameState.entities[5] = pawn
+
+	controller := new(stfake.Entity)
+	controller.On("PropertyValue", "m_hPawn").Return(st.PropertyValue{Any: uint64(5)}, true)
+	controller.On("PropertyValue", "m_hPlayerPawn").Return(st.PropertyValue{Any: uint64(5)}, true)
+
+	pl := common.NewPlayer(demoInfoProvider{parser: p})
+	pl.Entity = controller
+	pl.EntityID = 1
+	p.gameState.playersByEntityID[1] = pl
+
+	p.gameState.ingameTick = 10
+	p.trackPlayerPositions()
+
+	assert.Equal(t, r3.Vector{}, demoInfoProvider{parser: p}.EstimatedVelocity(pl))
+
+	p.gameState.ingameTick = 12
+	p.trackPlayerPositions()
+
+	assert.Equal(t, r3.Vector{X: 256, Y: -128}, demoInfoProvider{parser: p}.EstimatedVelocity(pl))
+
+	delete(p.gameState.playersByEntityID, 1)
+	p.trackPlayerPositions()
+
+	assert.Empty(t, p.gameState.velocityEstimates)
+	assert.Equal(t, r3.Vector{}, demoInfoProvider{parser: p}.EstimatedVelocity(pl))
+}
+
+func TestParser_HandleFrameParsed_ObserverTargetChanged(t *testing.T) {
+	p := newParser()
+	p.disableMimicSource1GameEvents = true
+
+	newObserverTestPlayer := func(entityID, userID, pawnID int) (*common.Player, *stfake.Entity) {
+		pawn := new(stfake.Entity)
+		pawn.On("ID").Return(pawnID)
+		pawn.On("Position").Return(r3.Vector{})
+		pawn.On("PropertyValue", "m_pObserverServices.m_iObserverMode").Return(st.PropertyValue{Any: uint64(common.ObserverModeInEye)}, true)
+		p.gameState.entities[pawnID] = pawn
+
+		controller := new(stfake.Entity)
+		controller.On("PropertyValue", "m_hPawn").Return(st.PropertyValue{Any: uint64(pawnID)}, true)
+		controller.On("PropertyValue", "m_hPlayerPawn").Return(st.PropertyValue{Any: uint64(pawnID)}, true)
+
+		pl := common.NewPlayer(demoInfoProvider{parser: p})
+		pl.Entity = controller
+		pl.EntityID = entityID
+		pl.UserID = userID
+		p.gameState.playersByEntityID[entityID] = pl
+		p.gameState.playersByUserID[userID] = pl
+
+		return pl, pawn
+	}
+
+	observer, observerPawn := newObserverTestPlayer(1, 11, 10)
+	target, targetPawn := newObserverTestPlayer(2, 12, 20)
+
+	targetPawn.On("PropertyValue", "m_pObserverServices.m_hObserverTarget").Return(st.PropertyValue{}, false)
+	observerPawn.On("PropertyValue", "m_pObserverServices.m_hObserverTarget").Return(st.PropertyValue{Any: uint64(20)}, true).Twice()
+	observerPawn.On("PropertyValue", "m_pObserverServices.m_hObserverTarget").Return(st.PropertyValue{Any: uint64(constants.InvalidEntityHandleSource2)}, true)
+
+	var changes []events.ObserverTargetChanged
+
+	p.RegisterEventHandler(func(e events.ObserverTargetChanged) {
+		changes = append(changes, e)
+	})
+
+	p.handleFrameParsed(nil)
+
+	assert.Equal(t, []events.ObserverTargetChanged{{
+		Observer:  observer,
+		NewTarget: target,
+		Mode:      common.ObserverModeInEye,
+	}}, changes)
+
+	changes = nil
+	p.handleFrameParsed(nil)
+
+	assert.Empty(t, changes, "unchanged target")
+
+	p.handleFrameParsed(nil)
+
+	assert.Equal(t, []events.ObserverTargetChanged{{
+		Observer:  observer,
+		OldTarget: target,
+		Mode:      common.ObserverModeInEye,
+	}}, changes)
+
+	delete(p.gameState.playersByEntityID, observer.EntityID)
+	p.handleFrameParsed(nil)
+
+	assert.NotContains(t, p.observerTargets, observer)
+}
+
+func TestParser_HandleFrameParsed_ObserverTargetChanged_Order(t *testing.T) {
+	// map iteration order is random, so repeat to catch non-deterministic dispatch order
+	for range 20 {
+		p := newParser()
+		p.disableMimicSource1GameEvents = true
+
+		newPawn := func(pawnID int, target uint64) {
+			pawn := new(stfake.Entity)
+			pawn.On("ID").Return(pawnID)
+			pawn.On("Position").Return(r3.Vector{})
+			pawn.On("PropertyValue", "m_pObserverServices.m_iObserverMode").Return(st.PropertyValue{Any: uint64(common.ObserverModeChase)}, true)
+			pawn.On("PropertyValue", "m_pObserverServices.m_hObserverTarget").Return(st.PropertyValue{Any: target}, true)
+			p.gameState.entities[pawnID] = pawn
+		}
+
+		newObserverTestPlayer := func(entityID, pawnID int) *common.Player {
+			controller := new(stfake.Entity)
+			controller.On("PropertyValue", "m_hPawn").Return(st.PropertyValue{Any: uint64(pawnID)}, true)
+			controller.On("PropertyValue", "m_hPlayerPawn").Return(st.PropertyValue{Any: uint64(pawnID)}, true)
+
+			pl := common.NewPlayer(demoInfoProvider{parser: p})
+			pl.Entity = controller
+			pl.EntityID = entityID
+			pl.UserID = entityID + 10
+			p.gameState.playersByEntityID[entityID] = pl
+			p.gameState.playersByUserID[pl.UserID] = pl
+
+			return pl
+		}
+
+		newPawn(100, uint64(constants.InvalidEntityHandleSource2))
+		target := newObserverTestPlayer(6, 100)
+
+		var observers []*common.Player
+
+		for _, entityID := range []int{5, 3, 1, 4, 2} {
+			newPawn(entityID*10, 100)
+			observers = append(observers, newObserverTestPlayer(entityID, entityID*10))
+		}
+
+		var changes []*common.Player
+
+		p.RegisterEventHandler(func(e events.ObserverTargetChanged) {
+			assert.Equal(t, target, e.NewTarget)
+
+			changes = append(changes, e.Observer)
+		})
+
+		p.handleFrameParsed(nil)
+
+		assert.Equal(t, []*common.Player{observers[2], observers[4], observers[1], observers[3], observers[0]}, changes)
+	}
+}
@@ -3,15 +3,20 @@ package demoinfocs
 import (
 	"fmt"
 	"io"
+	"maps"
 	"math"
+	"slices"
 	"time"
 
+	"github.com/golang/geo/r3"
 	"github.com/golang/snappy"
 	dispatch "github.com/markus-wa/godispatch"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/sendtablescs2"
 
 	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
@@ -70,7 +75,25 @@ func (p *parser) parseHeader() (header, error) {
 			}
 		}
 
-		p.stParser = sendtablescs2.NewParser(warnFunc)
+		stParser := sendtablescs2.NewParser(warnFunc)
+		if p.config.DispatchEntityEvents {
+			stParser.TrackChangedProperties()
+		}
+
+		if sel := p.config.EntitySelection; sel != nil {
+			stParser.SetEntityFilter(sel.includesClass, sel.includesProperty)
+		}
+
+		stParser.AddFieldPatches(p.config.FieldPatches...)
+
+		p.stParser = stParser
+
+		for _, sub := range p.propertyChangeSubscriptions {
+			err := p.stParser.OnPropertyChange(sub.classPattern, sub.propPattern, sub.handler)
+			if err != nil {
+				return h, err
+			}
+		}
 
 		p.stParser.OnEntity(p.onEntity)
 
@@ -362,11 +385,100 @@ var frameParsedToken = new(frameParsedTokenType)
 
 func (p *parser) handleFrameParsed(*frameParsedTokenType) {
 	p.processFrameGameEvents()
+	clear(p.chatMessagesThisFrame)
+	p.trackPlayerPositions()
+	p.gameState.trackBotControl()
+	p.trackObserverTargets()
 
 	p.currentFrame++
 	p.eventDispatcher.Dispatch(events.FrameDone{})
 }
 
+// velocityEstimate is the velocity of a player estimated from the position change between two frames.
+type velocityEstimate struct {
+	position r3.Vector // Position at tick
+	tick     int
+	velocity r3.Vector
+}
+
+// trackPlayerPositions keeps track of player positions to estimate velocities if the demo doesn't contain them.
+// See Player.Velocity().
+func (p *parser) trackPlayerPositions() {
+	estimates := p.gameState.velocityEstimates
+	tick := p.gameState.ingameTick
+	tickRate := p.TickRate()
+
+	for pl := range estimates {
+		if p.gameState.playersByEntityID[pl.EntityID] != pl {
+			delete(estimates, pl)
+		}
+	}
+
+	for _, pl := range p.gameState.playersByEntityID {
+		var pawnEntity st.Entity
+		if pl.Entity != nil {
+			pawnEntity = pl.PlayerPawnEntity()
+		}
+
+		if pawnEntity == nil {
+			delete(estimates, pl)
+
+			continue
+		}
+
+		pos := pawnEntity.Position()
+
+		estimate, ok := estimates[pl]
+		if !ok {
+			estimates[pl] = &velocityEstimate{position: pos, tick: tick}
+
+			continue
+		}
+
+		if tick > estimate.tick && tickRate > 0 {
+			estimate.velocity = pos.Sub(estimate.position).Mul(tickRate / float64(tick-estimate.tick))
+		}
+
+		estimate.position = pos
+		estimate.tick = tick
+	}
+}
+
+// trackObserverTargets dispatches ObserverTargetChanged for players whose camera started following another player.
+// Events of the same frame are dispatched in the order of the observers' entity IDs.
+func (p *parser) trackObserverTargets() {
+	for pl := range p.observerTargets {
+		if p.gameState.playersByEntityID[pl.EntityID] != pl {
+			delete(p.observerTargets, pl)
+		}
+	}
+
+	for _, entityID := range slices.Sorted(maps.Keys(p.gameState.playersByEntityID)) {
+		pl := p.gameState.playersByEntityID[entityID]
+		if pl.Entity == nil {
+			continue
+		}
+
+		target := pl.ObserverTarget()
+		if target == p.observerTargets[pl] {
+			continue
+		}
+
+		if p.observerTargets == nil {
+			p.observerTargets = make(map[*common.Player]*common.Player)
+		}
+
+		p.eventDispatcher.Dispatch(events.ObserverTargetChanged{
+			Observer:  pl,
+			OldTarget: p.observerTargets[pl],
+			NewTarget: target,
+			Mode:      pl.ObserverMode(),
+		})
+
+		p.observerTargets[pl] = target
+	}
+}
+
 // CS2 demos playback info are available in the CDemoFileInfo message that should be parsed at the end of the demo.
 // Demos may not contain it, as a workaround we update values with the last parser information at the end of parsing.
 func (p *parser) ensurePlaybackValuesAreSet() {
@@ -25,16 +25,26 @@ type Participants interface {
 	// The returned map is a snapshot and is not updated on changes (not a reference to the actual, underlying map).
 	// Includes spectators.
 	AllByUserID() map[int]*common.Player
+	// BySteamID64 returns all currently known human players & spectators, including disconnected ones,
+	// in a map where the key is the SteamID64.
+	// Unlike AllByUserID() this contains every person only once, with the Player instance of their latest connection.
+	// The returned map is a snapshot and is not updated on changes (not a reference to the actual, underlying map).
+	BySteamID64() map[uint64]*common.Player
+	// Identities returns the identities of all human players that connected during the demo,
+	// in a map where the key is the SteamID64.
+	// Identities stay the same across reconnects and contain the player's connection and bot control intervals.
+	// The returned map is a snapshot, but the identities themselves are updated.
+	Identities() map[uint64]*common.PlayerIdentity
 	// All returns all currently known players & spectators, including disconnected ones, of the demo.
 	// The returned slice is a snapshot and is not updated on changes.
 	All() []*common.Player
 	// Connected returns all currently connected players & spectators.
 	// The returned slice is a snapshot and is not updated on changes.
 	Connected() []*common.Player
-	// Playing returns all players that aren't spectating or unassigned.
+	// Playing returns all players that aren't spectating, unassigned or coaching, see Player.Role().
 	// The returned slice is a snapshot and is not updated on changes.
 	Playing() []*common.Player
-	// TeamMembers returns all players belonging to the requested team at this time.
+	// TeamMembers returns all players belonging to the requested team at this time, excluding coaches.
 	// The returned slice is a snapshot and is not updated on changes.
 	TeamMembers(team common.Team) []*common.Player
 	// FindByPawnHandle attempts to find a player by his pawn entity-handle.
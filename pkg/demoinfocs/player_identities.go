@@ -0,0 +1,111 @@
+package demoinfocs
+
+import (
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+)
+
+// identityConnected opens a new connection interval on the identity of a human player.
+// Returns the identity (nil for bots and players without SteamID) and whether the player had been connected before.
+func (gs *gameState) identityConnected(pl *common.Player) (identity *common.PlayerIdentity, isReconnect bool) {
+	if pl.IsBot || pl.SteamID64 == 0 {
+		return nil, false
+	}
+
+	identity = gs.playerIdentities[pl.SteamID64]
+	if identity == nil {
+		identity = &common.PlayerIdentity{SteamID64: pl.SteamID64}
+		gs.playerIdentities[pl.SteamID64] = identity
+	}
+
+	identity.Player = pl
+
+	if identity.IsConnected() {
+		identity.Connections[len(identity.Connections)-1].Player = pl
+
+		return identity, false
+	}
+
+	isReconnect = len(identity.Connections) > 0
+
+	identity.Connections = append(identity.Connections, common.ConnectionInterval{
+		Player:    pl,
+		StartTick: gs.ingameTick,
+		EndTick:   -1,
+	})
+
+	return identity, isReconnect
+}
+
+// identityDisconnected closes the current connection and bot control intervals of the player's identity.
+func (gs *gameState) identityDisconnected(pl *common.Player) {
+	identity := gs.playerIdentities[pl.SteamID64]
+	if identity == nil || !identity.IsConnected() {
+		return
+	}
+
+	identity.Connections[len(identity.Connections)-1].EndTick = gs.ingameTick
+	gs.setControlledBot(identity, nil)
+}
+
+// trackBotControl updates the bot control intervals of all connected identities.
+// See Player.IsControllingBot() and Player.ControlledBot().
+func (gs *gameState) trackBotControl() {
+	for _, identity := range gs.playerIdentities {
+		if !identity.IsConnected() {
+			continue
+		}
+
+		var bot *common.Player
+
+		if identity.Player.IsControllingBot() {
+			bot = identity.Player.ControlledBot()
+		}
+
+		gs.setControlledBot(identity, bot)
+	}
+}
+
+// setControlledBot ends the current bot control interval if the bot changed and starts a new one if bot isn't nil.
+func (gs *gameState) setControlledBot(identity *common.PlayerIdentity, bot *common.Player) {
+	if n := len(identity.BotControl); n > 0 && identity.BotControl[n-1].EndTick == -1 {
+		if identity.BotControl[n-1].Bot == bot {
+			return
+		}
+
+		identity.BotControl[n-1].EndTick = gs.ingameTick
+	}
+
+	if bot != nil {
+		identity.BotControl = append(identity.BotControl, common.BotControlInterval{
+			Bot:       bot,
+			StartTick: gs.ingameTick,
+			EndTick:   -1,
+		})
+	}
+}
+
+// dispatchPlayerDisconnected dispatches PlayerDisconnected at the end of the frame,
+// so the reason of a player_disconnect game-event of the same frame is available.
+// Reasons of earlier frames are kept until they are used or the player connects again, see setDisconnectReason().
+func (p *parser) dispatchPlayerDisconnected(pl *common.Player) {
+	p.delayedEventHandlers = append(p.delayedEventHandlers, func() {
+		reason := p.disconnectReasons[pl.SteamID64]
+		delete(p.disconnectReasons, pl.SteamID64)
+
+		p.eventDispatcher.Dispatch(events.PlayerDisconnected{
+			Player: pl,
+			Reason: reason,
+		})
+	})
+}
+
+// setDisconnectReason stores the reason of a player_disconnect game-event until the matching PlayerDisconnected is dispatched.
+func (p *parser) setDisconnectReason(steamID64 uint64, reason msg.ENetworkDisconnectionReason) {
+	if p.disconnectReasons == nil {
+		p.disconnectReasons = make(map[uint64]msg.ENetworkDisconnectionReason)
+	}
+
+	p.disconnectReasons[steamID64] = reason
+}
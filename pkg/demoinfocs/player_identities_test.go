@@ -0,0 +1,151 @@
+package demoinfocs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+)
+
+func TestPlayerIdentity_Reconnect(t *testing.T) {
+	p := newParser()
+	gs := p.gameState
+
+	pl := newPlayer()
+	pl.SteamID64 = 76561198000000001
+
+	gs.ingameTick = 10
+	identity, isReconnect := gs.identityConnected(pl)
+	assert.False(t, isReconnect)
+
+	gs.ingameTick = 20
+	bot := newPlayer()
+	bot.IsBot = true
+	gs.setControlledBot(identity, bot)
+
+	gs.ingameTick = 100
+	gs.identityDisconnected(pl)
+
+	gs.ingameTick = 150
+	reconnected := newPlayer()
+	reconnected.SteamID64 = pl.SteamID64
+	identity2, isReconnect := gs.identityConnected(reconnected)
+	assert.True(t, isReconnect)
+
+	assert.Same(t, identity, identity2)
+	assert.Equal(t, reconnected, identity.Player)
+	assert.Equal(t, []common.ConnectionInterval{
+		{Player: pl, StartTick: 10, EndTick: 100},
+		{Player: reconnected, StartTick: 150, EndTick: -1},
+	}, identity.Connections)
+	assert.Equal(t, []common.BotControlInterval{{Bot: bot, StartTick: 20, EndTick: 100}}, identity.BotControl)
+	assert.Equal(t, map[uint64]*common.PlayerIdentity{pl.SteamID64: identity}, gs.Participants().Identities())
+}
+
+func TestPlayerIdentity_IgnoresBots(t *testing.T) {
+	p := newParser()
+
+	bot := newPlayer()
+	bot.IsBot = true
+
+	identity, isReconnect := p.gameState.identityConnected(bot)
+
+	assert.Nil(t, identity)
+	assert.False(t, isReconnect)
+	assert.Empty(t, p.gameState.playerIdentities)
+}
+
+func TestDispatchPlayerDisconnected_Reason(t *testing.T) {
+	p := newParser()
+
+	pl := newPlayer()
+	pl.SteamID64 = 76561198000000001
+
+	var disconnects []events.PlayerDisconnected
+
+	p.RegisterEventHandler(func(e events.PlayerDisconnected) {
+		disconnects = append(disconnects, e)
+	})
+
+	p.dispatchPlayerDisconnected(pl)
+	p.setDisconnectReason(pl.SteamID64, msg.ENetworkDisconnectionReason_NETWORK_DISCONNECT_KICKED)
+
+	assert.Empty(t, disconnects)
+
+	p.processFrameGameEvents()
+
+	assert.Equal(t, []events.PlayerDisconnected{{
+		Player: pl,
+		Reason: msg.ENetworkDisconnectionReason_NETWORK_DISCONNECT_KICKED,
+	}}, disconnects)
+	assert.Empty(t, p.disconnectReasons)
+}
+
+func TestDispatchPlayerDisconnected_ReasonOfEarlierFrame(t *testing.T) {
+	p := newParser()
+
+	pl := newPlayer()
+	pl.SteamID64 = 76561198000000001
+
+	var disconnects []events.PlayerDisconnected
+
+	p.RegisterEventHandler(func(e events.PlayerDisconnected) {
+		disconnects = append(disconnects, e)
+	})
+
+	// player_disconnect game-event in one frame, entity update in the next
+	p.setDisconnectReason(pl.SteamID64, msg.ENetworkDisconnectionReason_NETWORK_DISCONNECT_KICKED)
+	p.processFrameGameEvents()
+
+	assert.Empty(t, disconnects)
+
+	p.dispatchPlayerDisconnected(pl)
+	p.processFrameGameEvents()
+
+	// the reason is only used once
+	p.dispatchPlayerDisconnected(pl)
+	p.processFrameGameEvents()
+
+	assert.Equal(t, []events.PlayerDisconnected{{
+		Player: pl,
+		Reason: msg.ENetworkDisconnectionReason_NETWORK_DISCONNECT_KICKED,
+	}, {
+		Player: pl,
+		Reason: msg.ENetworkDisconnectionReason_NETWORK_DISCONNECT_INVALID,
+	}}, disconnects)
+	assert.Empty(t, p.disconnectReasons)
+}
+
+func TestGameEventHandler_PlayerDisconnect_Bot(t *testing.T) {
+	p := newParser()
+
+	bot := newPlayer()
+	bot.IsBot = true
+	bot.UserID = 3
+	p.gameState.playersByUserID[3] = bot
+
+	var disconnects []events.PlayerDisconnected
+
+	p.RegisterEventHandler(func(e events.PlayerDisconnected) {
+		disconnects = append(disconnects, e)
+	})
+
+	p.gameEventHandler.playerDisconnect(map[string]*msg.CMsgSource1LegacyGameEventKeyT{
+		"userid": {ValShort: proto.Int32(3)},
+		"reason": {ValShort: proto.Int32(int32(msg.ENetworkDisconnectionReason_NETWORK_DISCONNECT_KICKED))},
+	})
+
+	assert.False(t, bot.IsConnected)
+	assert.Empty(t, disconnects)
+
+	p.processFrameGameEvents()
+
+	assert.Equal(t, []events.PlayerDisconnected{{
+		Player: bot,
+		Reason: msg.ENetworkDisconnectionReason_NETWORK_DISCONNECT_KICKED,
+	}}, disconnects)
+}
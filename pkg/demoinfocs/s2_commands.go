@@ -380,6 +380,15 @@ func (p *parser) handleFileInfo(msg *msg.CDemoFileInfo) {
 	p.header.PlaybackTicks = int(*msg.PlaybackTicks)
 	p.header.PlaybackFrames = int(*msg.PlaybackFrames)
 	p.header.PlaybackTime = time.Duration(*msg.PlaybackTime) * time.Second
+
+	if cs := msg.GetGameInfo().GetCs(); cs != nil {
+		p.matchInfo.GameInfo = cs
+		p.matchInfo.RoundStartTicks = make([]int, len(cs.RoundStartTicks))
+
+		for i, tick := range cs.RoundStartTicks {
+			p.matchInfo.RoundStartTicks[i] = int(tick)
+		}
+	}
 }
 
 //go:embed event-list-dump/*.bin
@@ -411,6 +420,9 @@ func (p *parser) handleDemoFileHeader(msg *msg.CDemoFileHeader) {
 	p.header.MapName = msg.GetMapName()
 	networkProtocol := int(msg.GetNetworkProtocol())
 	p.header.NetworkProtocol = networkProtocol
+	p.header.BuildNum = int(msg.GetBuildNum())
+
+	p.stParser.SetGameBuild(uint32(msg.GetBuildNum()))
 
 	if p.source2FallbackGameEventListBin == nil {
 		gameEventListBin, err := getGameEventListBinForProtocol(networkProtocol)
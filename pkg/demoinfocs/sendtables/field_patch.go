@@ -0,0 +1,23 @@
+package sendtables
+
+// FieldDefinition contains the attributes of a field (property) that define how it is decoded.
+// See FieldPatch.
+type FieldDefinition struct {
+	VarName     string // Name of the field without any parent prefix, e.g. "m_flSimulationTime"
+	VarType     string
+	Encoder     string
+	EncodeFlags *int32
+	BitCount    *int32
+	LowValue    *float32
+	HighValue   *float32
+}
+
+// FieldPatch modifies field definitions before their decoders are set up.
+// Can be used to override encoders etc. after a game update broke decoding of some properties.
+//
+// Patches are applied to all fields of demos whose game build is within [MinBuild, MaxBuild].
+type FieldPatch struct {
+	MinBuild uint32 // 0 for no lower bound
+	MaxBuild uint32 // 0 for no upper bound
+	Patch    func(f *FieldDefinition)
+}
@@ -0,0 +1,254 @@
+package sendtables
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Schema describes all server-classes of a demo and how their properties are encoded.
+// It can be used to find out which properties were added, removed, renamed or re-encoded between game builds.
+// See ServerClasses.Schema() and Diff().
+type Schema struct {
+	Classes []ClassSchema `json:"classes"` // Sorted by name
+}
+
+// ClassSchema describes a server-class and its properties.
+type ClassSchema struct {
+	ID         int           `json:"id"`
+	Name       string        `json:"name"`
+	Serializer string        `json:"serializer"`
+	Fields     []FieldSchema `json:"fields"` // Flattened, in network order
+}
+
+// FieldSchema describes how a single property (field) is encoded.
+//
+// Name is the full property name as used in Entity.Property(),
+// except that indices of variable tables are replaced by '*' (e.g. "m_vecFoo.*.m_bar").
+// Elements of arrays are not listed individually.
+type FieldSchema struct {
+	Name        string   `json:"name"`
+	VarType     string   `json:"varType"`
+	Encoder     string   `json:"encoder,omitempty"`
+	EncodeFlags *int32   `json:"encodeFlags,omitempty"`
+	BitCount    *int32   `json:"bitCount,omitempty"`
+	LowValue    *float32 `json:"lowValue,omitempty"`
+	HighValue   *float32 `json:"highValue,omitempty"`
+	Model       string   `json:"model"`                // One of "simple", "fixed-array", "fixed-table", "variable-array" or "variable-table"
+	Serializer  string   `json:"serializer,omitempty"` // Name of the serializer of table fields
+}
+
+// Class returns the schema of the server-class with the given name or nil if it doesn't exist.
+func (s Schema) Class(name string) *ClassSchema {
+	for i := range s.Classes {
+		if s.Classes[i].Name == name {
+			return &s.Classes[i]
+		}
+	}
+
+	return nil
+}
+
+// Field returns the schema of the field with the given name or nil if it doesn't exist.
+func (c ClassSchema) Field(name string) *FieldSchema {
+	for i := range c.Fields {
+		if c.Fields[i].Name == name {
+			return &c.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes the schema as indented JSON to w.
+func (s Schema) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(s)
+}
+
+// ReadSchemaJSON reads a schema that was written with Schema.WriteJSON().
+func ReadSchemaJSON(r io.Reader) (Schema, error) {
+	var s Schema
+
+	err := json.NewDecoder(r).Decode(&s)
+	if err != nil {
+		return Schema{}, fmt.Errorf("failed to decode schema: %w", err)
+	}
+
+	return s, nil
+}
+
+// SameEncoding returns true if both fields are encoded the same way, ignoring their names.
+func (f FieldSchema) SameEncoding(other FieldSchema) bool {
+	return f.VarType == other.VarType &&
+		f.Encoder == other.Encoder &&
+		equalPtr(f.EncodeFlags, other.EncodeFlags) &&
+		equalPtr(f.BitCount, other.BitCount) &&
+		equalPtr(f.LowValue, other.LowValue) &&
+		equalPtr(f.HighValue, other.HighValue) &&
+		f.Model == other.Model &&
+		f.Serializer == other.Serializer
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// SchemaDiff contains the differences between two schemas, see Diff().
+type SchemaDiff struct {
+	AddedClasses   []string    `json:"addedClasses,omitempty"`
+	RemovedClasses []string    `json:"removedClasses,omitempty"`
+	ChangedClasses []ClassDiff `json:"changedClasses,omitempty"`
+}
+
+// ClassDiff contains the differences of a server-class that exists in both schemas.
+type ClassDiff struct {
+	Name string `json:"name"`
+
+	AddedFields   []FieldSchema `json:"addedFields,omitempty"`
+	RemovedFields []FieldSchema `json:"removedFields,omitempty"`
+
+	// Fields that exist in both schemas but are encoded differently.
+	ChangedFields []FieldChange `json:"changedFields,omitempty"`
+
+	// Likely renames, i.e. a removed and an added field on the same level with the same encoding.
+	// These fields are not listed in AddedFields and RemovedFields.
+	RenamedFields []FieldChange `json:"renamedFields,omitempty"`
+}
+
+// FieldChange is a field as it was in the old and as it is in the new schema.
+type FieldChange struct {
+	Old FieldSchema `json:"old"`
+	New FieldSchema `json:"new"`
+}
+
+// IsEmpty returns true if there are no differences.
+func (d SchemaDiff) IsEmpty() bool {
+	return len(d.AddedClasses) == 0 && len(d.RemovedClasses) == 0 && len(d.ChangedClasses) == 0
+}
+
+// Diff returns the differences between the old schema a and the new schema b.
+// Server-class IDs are not compared as they aren't stable between game builds.
+func Diff(a, b Schema) SchemaDiff {
+	var d SchemaDiff
+
+	for _, ca := range a.Classes {
+		cb := b.Class(ca.Name)
+		if cb == nil {
+			d.RemovedClasses = append(d.RemovedClasses, ca.Name)
+
+			continue
+		}
+
+		if cd := diffClass(ca, *cb); !cd.isEmpty() {
+			d.ChangedClasses = append(d.ChangedClasses, cd)
+		}
+	}
+
+	for _, cb := range b.Classes {
+		if a.Class(cb.Name) == nil {
+			d.AddedClasses = append(d.AddedClasses, cb.Name)
+		}
+	}
+
+	sort.Strings(d.AddedClasses)
+	sort.Strings(d.RemovedClasses)
+	sort.Slice(d.ChangedClasses, func(i, j int) bool {
+		return d.ChangedClasses[i].Name < d.ChangedClasses[j].Name
+	})
+
+	return d
+}
+
+func diffClass(a, b ClassSchema) ClassDiff {
+	d := ClassDiff{Name: a.Name}
+
+	for _, fa := range a.Fields {
+		fb := b.Field(fa.Name)
+		if fb == nil {
+			d.RemovedFields = append(d.RemovedFields, fa)
+		} else if !fa.SameEncoding(*fb) {
+			d.ChangedFields = append(d.ChangedFields, FieldChange{Old: fa, New: *fb})
+		}
+	}
+
+	for _, fb := range b.Fields {
+		if a.Field(fb.Name) == nil {
+			d.AddedFields = append(d.AddedFields, fb)
+		}
+	}
+
+	d.detectRenames()
+
+	return d
+}
+
+// detectRenames moves pairs of removed and added fields to RenamedFields
+// if they are the only fields on the same level (same parent) with the same encoding.
+func (d *ClassDiff) detectRenames() {
+	candidates := func(f FieldSchema, fields []FieldSchema) []int {
+		var res []int
+
+		for i, other := range fields {
+			if parentName(f.Name) == parentName(other.Name) && f.SameEncoding(other) {
+				res = append(res, i)
+			}
+		}
+
+		return res
+	}
+
+	renamedOld := make(map[int]bool)
+	renamedNew := make(map[int]bool)
+
+	for i, removed := range d.RemovedFields {
+		added := candidates(removed, d.AddedFields)
+		if len(added) != 1 || len(candidates(d.AddedFields[added[0]], d.RemovedFields)) != 1 {
+			continue
+		}
+
+		d.RenamedFields = append(d.RenamedFields, FieldChange{Old: removed, New: d.AddedFields[added[0]]})
+		renamedOld[i] = true
+		renamedNew[added[0]] = true
+	}
+
+	d.RemovedFields = withoutIndices(d.RemovedFields, renamedOld)
+	d.AddedFields = withoutIndices(d.AddedFields, renamedNew)
+}
+
+func parentName(name string) string {
+	i := strings.LastIndex(name, ".")
+	if i == -1 {
+		return ""
+	}
+
+	return name[:i]
+}
+
+func withoutIndices(fields []FieldSchema, indices map[int]bool) []FieldSchema {
+	if len(indices) == 0 {
+		return fields
+	}
+
+	var res []FieldSchema
+
+	for i, f := range fields {
+		if !indices[i] {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+func (d ClassDiff) isEmpty() bool {
+	return len(d.AddedFields) == 0 && len(d.RemovedFields) == 0 && len(d.ChangedFields) == 0 && len(d.RenamedFields) == 0
+}
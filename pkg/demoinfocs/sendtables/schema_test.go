@@ -0,0 +1,82 @@
+package sendtables
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestDiff(t *testing.T) {
+	health := FieldSchema{Name: "m_iHealth", VarType: "int32", Model: "simple"}
+	armor := FieldSchema{Name: "m_ArmorValue", VarType: "int32", Model: "simple"}
+	origin := FieldSchema{Name: "m_vecOrigin", VarType: "Vector", Encoder: "coord", Model: "simple"}
+	angle := FieldSchema{Name: "m_angEyeAngles", VarType: "QAngle", BitCount: ptr(int32(32)), Model: "simple"}
+	weapon := FieldSchema{Name: "m_pWeaponServices.m_hActiveWeapon", VarType: "CHandle< CBasePlayerWeapon >", Model: "simple"}
+
+	angleReEncoded := angle
+	angleReEncoded.BitCount = ptr(int32(20))
+
+	weaponRenamed := weapon
+	weaponRenamed.Name = "m_pWeaponServices.m_hCurrentWeapon"
+
+	a := Schema{Classes: []ClassSchema{
+		{ID: 1, Name: "CCSPlayerPawn", Fields: []FieldSchema{health, armor, origin, angle, weapon}},
+		{ID: 2, Name: "CRemoved"},
+		{ID: 3, Name: "CUnchanged", Fields: []FieldSchema{health}},
+	}}
+	b := Schema{Classes: []ClassSchema{
+		{ID: 1, Name: "CAdded"},
+		{ID: 2, Name: "CCSPlayerPawn", Fields: []FieldSchema{health, origin, angleReEncoded, weaponRenamed}},
+		{ID: 5, Name: "CUnchanged", Fields: []FieldSchema{health}},
+	}}
+
+	assert.Equal(t, SchemaDiff{
+		AddedClasses:   []string{"CAdded"},
+		RemovedClasses: []string{"CRemoved"},
+		ChangedClasses: []ClassDiff{{
+			Name:          "CCSPlayerPawn",
+			RemovedFields: []FieldSchema{armor},
+			ChangedFields: []FieldChange{{Old: angle, New: angleReEncoded}},
+			RenamedFields: []FieldChange{{Old: weapon, New: weaponRenamed}},
+		}},
+	}, Diff(a, b))
+	assert.True(t, Diff(a, a).IsEmpty())
+}
+
+func TestDiff_AmbiguousRename(t *testing.T) {
+	a := Schema{Classes: []ClassSchema{{Name: "C", Fields: []FieldSchema{
+		{Name: "m_a", VarType: "int32"},
+	}}}}
+	b := Schema{Classes: []ClassSchema{{Name: "C", Fields: []FieldSchema{
+		{Name: "m_b", VarType: "int32"},
+		{Name: "m_c", VarType: "int32"},
+	}}}}
+
+	d := Diff(a, b)
+
+	assert.Len(t, d.ChangedClasses, 1)
+	assert.Empty(t, d.ChangedClasses[0].RenamedFields)
+	assert.Len(t, d.ChangedClasses[0].AddedFields, 2)
+	assert.Len(t, d.ChangedClasses[0].RemovedFields, 1)
+}
+
+func TestSchema_JSON(t *testing.T) {
+	s := Schema{Classes: []ClassSchema{{ID: 1, Name: "CCSPlayerPawn", Serializer: "CCSPlayerPawn", Fields: []FieldSchema{
+		{Name: "m_angEyeAngles", VarType: "QAngle", BitCount: ptr(int32(32)), LowValue: ptr(float32(-1)), Model: "simple"},
+	}}}}
+
+	var buf bytes.Buffer
+
+	err := s.WriteJSON(&buf)
+	assert.NoError(t, err)
+
+	actual, err := ReadSchemaJSON(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, s, actual)
+	assert.True(t, Diff(s, actual).IsEmpty())
+}
@@ -97,6 +97,10 @@ func (v PropertyValue) BoolVal() bool {
 // PropertyUpdateHandler is the interface for handlers that are interested in property changes.
 type PropertyUpdateHandler func(PropertyValue)
 
+// PropertyChangeHandler is the interface for handlers that are interested in property changes of multiple entities or properties.
+// Receives the entity, the full name of the changed property and its old and new value.
+type PropertyChangeHandler func(entity Entity, name string, oldValue, newValue PropertyValue)
+
 type PropertyEntry struct {
 	Name    string
 	IsArray bool
@@ -109,6 +113,8 @@ type EntityCreatedHandler func(Entity)
 type ServerClasses interface {
 	All() []ServerClass
 	FindByName(name string) ServerClass
+	// Schema returns the schema of all server-classes, see Diff().
+	Schema() Schema
 }
 
 // EntityOp is a bitmask representing the type of operation performed on an Entity
@@ -2,6 +2,7 @@ package sendtablescs2
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
@@ -10,6 +11,61 @@ import (
 type fpNameTreeCache struct {
 	next map[int]*fpNameTreeCache
 	name string
+
+	// property-change handlers matching this field path, valid if changeHandlersVersion == propertyChangeSubscriptions.version
+	changeHandlers        []st.PropertyChangeHandler
+	changeHandlersVersion int
+
+	// entity filter results, see class.isExcluded()
+	filterState filterState
+	skipWidth   uint32 // number of bits to skip if the field is excluded, 0 if the field needs to be decoded
+}
+
+type filterState uint8
+
+const (
+	filterStateUnknown filterState = iota
+	filterStateIncluded
+	filterStateExcluded
+)
+
+// entityFilter restricts which entities and fields are decoded, see Parser.SetEntityFilter().
+type entityFilter struct {
+	includeClass func(className string) bool
+	includeField func(className, fieldName string) bool
+}
+
+type propertyChangeSubscription struct {
+	classPattern string
+	propPattern  string
+	handler      st.PropertyChangeHandler
+}
+
+// propertyChangeSubscriptions is shared between the parser and all classes.
+// version is incremented with every new subscription to invalidate the handlers cached per field path.
+type propertyChangeSubscriptions struct {
+	subs    []propertyChangeSubscription
+	version int
+}
+
+func (pcs *propertyChangeSubscriptions) add(sub propertyChangeSubscription) {
+	pcs.subs = append(pcs.subs, sub)
+	pcs.version++
+}
+
+func (pcs *propertyChangeSubscriptions) match(className, propName string) (handlers []st.PropertyChangeHandler) {
+	for _, sub := range pcs.subs {
+		// patterns are validated when subscribing, so we can ignore the errors here
+		if ok, _ := path.Match(sub.classPattern, className); !ok {
+			continue
+		}
+
+		if ok, _ := path.Match(sub.propPattern, propName); ok {
+			handlers = append(handlers, sub.handler)
+		}
+	}
+
+	return
 }
 
 type class struct {
@@ -18,6 +74,9 @@ type class struct {
 	serializer      *serializer
 	createdHandlers []st.EntityCreatedHandler
 	fpNameCache     *fpNameTreeCache
+	propertyChanges *propertyChangeSubscriptions
+	filter          *entityFilter
+	filterState     filterState
 }
 
 func (c *class) ID() int {
@@ -62,6 +121,61 @@ func (c *class) collectFieldsEntries(fields []*field, prefix string) []string {
 }
 
 func (c *class) getNameForFieldPath(fp *fieldPath) string {
+	return c.getFieldPathCache(fp).name
+}
+
+// getPropertyChangeHandlers returns the property-change handlers matching a field path.
+// The matching is done once per field path and cached until new handlers are subscribed.
+func (c *class) getPropertyChangeHandlers(node *fpNameTreeCache) []st.PropertyChangeHandler {
+	if c.propertyChanges == nil || len(c.propertyChanges.subs) == 0 {
+		return nil
+	}
+
+	if node.changeHandlersVersion != c.propertyChanges.version {
+		node.changeHandlers = c.propertyChanges.match(c.name, node.name)
+		node.changeHandlersVersion = c.propertyChanges.version
+	}
+
+	return node.changeHandlers
+}
+
+// isExcluded returns true if the field shouldn't be decoded (because it or its whole class is excluded by the entity filter).
+// The result is cached per class and field path.
+func (c *class) isExcluded(node *fpNameTreeCache, f *field, base bool) bool {
+	if c.filter == nil {
+		return false
+	}
+
+	if node.filterState == filterStateUnknown {
+		node.filterState = filterStateIncluded
+
+		if c.isClassExcluded() || c.filter.includeField != nil && !c.filter.includeField(c.name, node.name) {
+			node.filterState = filterStateExcluded
+
+			// base decoders need to be executed as they may have side effects (e.g. polymorphic fixed tables)
+			// and child decoders of variable arrays use the generic type, which fixedBitWidth() doesn't handle.
+			if !base && (f.model == fieldModelSimple || f.model == fieldModelFixedArray) {
+				node.skipWidth, _ = fixedBitWidth(f)
+			}
+		}
+	}
+
+	return node.filterState == filterStateExcluded
+}
+
+func (c *class) isClassExcluded() bool {
+	if c.filterState == filterStateUnknown {
+		c.filterState = filterStateIncluded
+
+		if c.filter.includeClass != nil && !c.filter.includeClass(c.name) {
+			c.filterState = filterStateExcluded
+		}
+	}
+
+	return c.filterState == filterStateExcluded
+}
+
+func (c *class) getFieldPathCache(fp *fieldPath) *fpNameTreeCache {
 	currentCacheNode := c.fpNameCache
 
 	for i := 0; i <= fp.last; i++ {
@@ -82,7 +196,7 @@ func (c *class) getNameForFieldPath(fp *fieldPath) string {
 		currentCacheNode.name = strings.Join(c.serializer.getNameForFieldPath(fp, 0), ".")
 	}
 
-	return currentCacheNode.name
+	return currentCacheNode
 }
 
 func (c *class) getTypeForFieldPath(fp *fieldPath) *fieldType {
@@ -28,12 +28,30 @@ type Entity struct {
 	onDestroy        []func()
 	updateHandlers   map[string][]st.PropertyUpdateHandler
 	propCache        map[string]st.Property
+
+	trackChanges bool
+	changedProps []string
+
+	version uint64
 }
 
 func (e *Entity) ServerClass() st.ServerClass {
 	return e.class
 }
 
+// ChangedProperties returns the names of the properties that were changed by the latest update of the entity.
+// Only available if Parser.TrackChangedProperties() was called, returns nil otherwise.
+// The returned slice is reused for subsequent updates.
+func (e *Entity) ChangedProperties() []string {
+	return e.changedProps
+}
+
+// Version returns a counter that is incremented with every update of the entity.
+// Can be used to find out whether an entity changed since it was last looked at.
+func (e *Entity) Version() uint64 {
+	return e.version
+}
+
 func (e *Entity) ID() int {
 	return int(e.index)
 }
@@ -411,13 +429,42 @@ func (p *Parser) FilterEntity(fb func(*Entity) bool) []*Entity {
 func (e *Entity) readFields(r *reader, paths *[]*fieldPath) {
 	n := readFieldPaths(r, paths)
 
+	e.version++
+
 	for _, fp := range (*paths)[:n] {
 		f := e.class.serializer.getFieldForFieldPath(fp, 0)
-		name := e.class.getNameForFieldPath(fp)
+		fpCache := e.class.getFieldPathCache(fp)
+		name := fpCache.name
 		decoder, base := e.class.serializer.getDecoderForFieldPath2(fp, 0)
 
+		if e.class.isExcluded(fpCache, f, base) {
+			if fpCache.skipWidth > 0 {
+				r.skipBits(fpCache.skipWidth)
+			} else {
+				decoder(r)
+			}
+
+			continue
+		}
+
+		changeHandlers := e.class.getPropertyChangeHandlers(fpCache)
+
+		if e.trackChanges {
+			e.changedProps = append(e.changedProps, name)
+		}
+
 		val := decoder(r)
 
+		var oldVal any
+
+		if len(changeHandlers) > 0 {
+			oldVal = e.state.get(fp)
+			if oldFS, ok := oldVal.(*fieldState); ok {
+				// the old state may be re-used for the new value
+				oldVal = slices.Clone(oldFS.state)
+			}
+		}
+
 		if base && (f.model == fieldModelVariableArray || f.model == fieldModelVariableTable) {
 			fs := fieldState{}
 
@@ -454,6 +501,10 @@ func (e *Entity) readFields(r *reader, paths *[]*fieldPath) {
 				Any: val,
 			})
 		}
+
+		for _, h := range changeHandlers {
+			h(e, name, st.PropertyValue{Any: oldVal}, st.PropertyValue{Any: val})
+		}
 	}
 }
 
@@ -513,6 +564,7 @@ func (p *Parser) OnPacketEntities(m *msg.CSVCMsg_PacketEntities) error {
 				}
 
 				e = newEntity(index, serial, class)
+				e.trackChanges = p.trackChangedProperties
 				p.entities[index] = e
 
 				baseline := p.classBaselines[classID]
@@ -551,6 +603,7 @@ func (p *Parser) OnPacketEntities(m *msg.CSVCMsg_PacketEntities) error {
 					op |= st.EntityOpEntered
 				}
 
+				e.changedProps = e.changedProps[:0]
 				e.readFields(r, &p.pathCache)
 			}
 		} else {
@@ -604,6 +657,12 @@ func (p *Parser) OnPacketEntities(m *msg.CSVCMsg_PacketEntities) error {
 	return nil
 }
 
+// TrackChangedProperties enables tracking of the properties changed by each entity update.
+// See Entity.ChangedProperties().
+func (p *Parser) TrackChangedProperties() {
+	p.trackChangedProperties = true
+}
+
 // OnEntity registers an EntityHandler that will be called when an entity
 // is created, updated, deleted, etc.
 func (p *Parser) OnEntity(h st.EntityHandler) {
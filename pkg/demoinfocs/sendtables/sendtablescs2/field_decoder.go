@@ -421,6 +421,89 @@ func findDecoder(f *field) fieldDecoder {
 	return defaultDecoder
 }
 
+// fixedBitWidth returns the number of bits the decoder returned by findDecoder() reads, if it's always the same.
+// Used to skip fields that are excluded by the entity filter without decoding them.
+func fixedBitWidth(f *field) (uint32, bool) {
+	// see findDecoder() for the order in which decoders are looked up
+	_, hasFactory := fieldTypeFactories[f.fieldType.baseType]
+	if _, ok := fieldNameDecoders[f.varName]; ok && !hasFactory {
+		return 0, false
+	}
+
+	switch f.fieldType.baseType {
+	case "bool":
+		return 1, true
+
+	case "GameTime_t":
+		return 32, true
+
+	case "float32":
+		return floatBitWidth(f)
+
+	case "CNetworkedQuantizedFloat":
+		return quantizedBitWidth(f)
+
+	case "Vector", "VectorWS":
+		return vectorBitWidth(f, 3)
+
+	case "Vector2D":
+		return vectorBitWidth(f, 2)
+
+	case "Vector4D", "Quaternion":
+		return vectorBitWidth(f, 4)
+
+	case "CTransform":
+		return vectorBitWidth(f, 6)
+
+	case "QAngle":
+		return qangleBitWidth(f)
+	}
+
+	return 0, false
+}
+
+func floatBitWidth(f *field) (uint32, bool) {
+	switch f.encoder {
+	case "coord", "simtime":
+		return 0, false
+	case "runetime":
+		return 4, true
+	}
+
+	return quantizedBitWidth(f)
+}
+
+func quantizedBitWidth(f *field) (uint32, bool) {
+	if f.bitCount == nil || (*f.bitCount <= 0 || *f.bitCount >= 32) {
+		return 32, true
+	}
+
+	qfd := newQuantizedFloatDecoder(f.bitCount, f.encodeFlags, f.lowValue, f.highValue)
+	if qfd.Flags&(qff_rounddown|qff_roundup|qff_encode_zero) != 0 {
+		return 0, false
+	}
+
+	return qfd.Bitcount, true
+}
+
+func vectorBitWidth(f *field, n uint32) (uint32, bool) {
+	if n == 3 && f.encoder == "normal" {
+		return 0, false
+	}
+
+	width, ok := floatBitWidth(f)
+
+	return n * width, ok
+}
+
+func qangleBitWidth(f *field) (uint32, bool) {
+	if f.encoder == "qangle_precise" || f.bitCount == nil || *f.bitCount == 0 {
+		return 0, false
+	}
+
+	return 3 * uint32(*f.bitCount), true
+}
+
 func findDecoderByBaseType(f *field) fieldDecoder {
 	if v, ok := fieldTypeFactories[f.fieldType.genericType.baseType]; ok {
 		return v(f)
@@ -0,0 +1,119 @@
+package sendtablescs2
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// bitPos returns the number of bits read so far.
+func bitPos(r *reader) uint32 {
+	return r.pos*8 - r.bitCount
+}
+
+func testBuffer() []byte {
+	buf := make([]byte, 256)
+	rand.New(rand.NewSource(1)).Read(buf)
+
+	return buf
+}
+
+func TestFixedBitWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		field *field
+		fixed bool
+	}{
+		{"bool", &field{varType: "bool"}, true},
+		{"GameTime_t", &field{varType: "GameTime_t"}, true},
+		{"float32 noscale", &field{varType: "float32"}, true},
+		{"float32 bitcount 32", &field{varType: "float32", bitCount: ptr(int32(32))}, true},
+		{"float32 quantized", &field{varType: "float32", bitCount: ptr(int32(10)), lowValue: ptr(float32(-1)), highValue: ptr(float32(1))}, true},
+		{"float32 quantized integers", &field{varType: "float32", bitCount: ptr(int32(4)), encodeFlags: ptr(int32(qff_encode_integers)), highValue: ptr(float32(100))}, true},
+		{"float32 quantized encode zero", &field{varType: "float32", bitCount: ptr(int32(6)), encodeFlags: ptr(int32(qff_encode_zero)), lowValue: ptr(float32(-1.3)), highValue: ptr(float32(2.9))}, false},
+		{"float32 runetime", &field{varType: "float32", encoder: "runetime"}, true},
+		{"float32 coord", &field{varType: "float32", encoder: "coord"}, false},
+		{"float32 simtime", &field{varType: "float32", encoder: "simtime"}, false},
+		{"CNetworkedQuantizedFloat", &field{varType: "CNetworkedQuantizedFloat", bitCount: ptr(int32(20)), lowValue: ptr(float32(0)), highValue: ptr(float32(360))}, true},
+		{"CNetworkedQuantizedFloat noscale", &field{varType: "CNetworkedQuantizedFloat"}, true},
+		{"Vector noscale", &field{varType: "Vector"}, true},
+		{"Vector quantized", &field{varType: "Vector", bitCount: ptr(int32(12)), lowValue: ptr(float32(-4096)), highValue: ptr(float32(4096))}, true},
+		{"Vector coord", &field{varType: "Vector", encoder: "coord"}, false},
+		{"Vector normal", &field{varType: "Vector", encoder: "normal"}, false},
+		{"VectorWS", &field{varType: "VectorWS"}, true},
+		{"Vector2D", &field{varType: "Vector2D", bitCount: ptr(int32(7))}, true},
+		{"Vector4D", &field{varType: "Vector4D"}, true},
+		{"Quaternion", &field{varType: "Quaternion", bitCount: ptr(int32(11))}, true},
+		{"CTransform", &field{varType: "CTransform"}, true},
+		{"QAngle bitcount", &field{varType: "QAngle", bitCount: ptr(int32(13))}, true},
+		{"QAngle coord", &field{varType: "QAngle"}, false},
+		{"QAngle precise", &field{varType: "QAngle", encoder: "qangle_precise", bitCount: ptr(int32(20))}, false},
+		{"int32", &field{varType: "int32"}, false},
+		{"m_iClip1", &field{varName: "m_iClip1", varType: "int32"}, false},
+	}
+
+	buf := testBuffer()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.field.fieldType = newFieldType(test.field.varType)
+
+			width, ok := fixedBitWidth(test.field)
+			assert.Equal(t, test.fixed, ok)
+
+			if !ok {
+				return
+			}
+
+			decoder := findDecoder(test.field)
+
+			for offset := uint32(0); offset < 8; offset++ {
+				r := newReader(buf)
+				r.skipBits(offset)
+
+				decoder(r)
+
+				assert.Equal(t, width, bitPos(r)-offset, "bit offset %d", offset)
+			}
+		})
+	}
+}
+
+func TestReader_SkipBits(t *testing.T) {
+	buf := testBuffer()
+
+	for _, offset := range []uint32{0, 1, 7, 8, 13} {
+		for _, n := range []uint32{0, 1, 3, 7, 8, 9, 31, 32, 33, 64, 100, 1000} {
+			skipped := newReader(buf)
+			skipped.readBits(offset)
+			skipped.skipBits(n)
+
+			read := newReader(buf)
+			read.readBits(offset)
+
+			for rem := n; rem > 0; {
+				bits := uint32(32)
+				if rem < bits {
+					bits = rem
+				}
+
+				read.readBits(bits)
+				rem -= bits
+			}
+
+			assert.Equal(t, bitPos(read), bitPos(skipped), "offset %d, n %d", offset, n)
+			assert.Equal(t, read.readBits(17), skipped.readBits(17), "offset %d, n %d", offset, n)
+		}
+	}
+}
+
+func TestReader_SkipBits_InsufficientBuffer(t *testing.T) {
+	r := newReader(make([]byte, 4))
+
+	assert.Panics(t, func() { r.skipBits(41) })
+}
@@ -1,5 +1,9 @@
 package sendtablescs2
 
+import (
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
 type fieldPatch struct {
 	minBuild uint32
 	maxBuild uint32
@@ -22,10 +26,40 @@ var fieldPatches = []fieldPatch{
 	}},
 }
 
+// shouldApply returns true if the build is within [minBuild, maxBuild], 0 means no lower/upper bound.
 func (p *fieldPatch) shouldApply(build uint32) bool {
-	if p.minBuild == 0 && p.maxBuild == 0 {
-		return true
+	if p.minBuild != 0 && build < p.minBuild {
+		return false
 	}
 
-	return build >= p.minBuild && build <= p.maxBuild
+	return p.maxBuild == 0 || build <= p.maxBuild
+}
+
+// newUserFieldPatch wraps a user-defined patch that operates on st.FieldDefinition.
+func newUserFieldPatch(userPatch st.FieldPatch) fieldPatch {
+	return fieldPatch{
+		minBuild: userPatch.MinBuild,
+		maxBuild: userPatch.MaxBuild,
+		patch: func(f *field) {
+			def := st.FieldDefinition{
+				VarName:     f.varName,
+				VarType:     f.varType,
+				Encoder:     f.encoder,
+				EncodeFlags: f.encodeFlags,
+				BitCount:    f.bitCount,
+				LowValue:    f.lowValue,
+				HighValue:   f.highValue,
+			}
+
+			userPatch.Patch(&def)
+
+			f.varName = def.VarName
+			f.varType = def.VarType
+			f.encoder = def.Encoder
+			f.encodeFlags = def.EncodeFlags
+			f.bitCount = def.BitCount
+			f.lowValue = def.LowValue
+			f.highValue = def.HighValue
+		},
+	}
 }
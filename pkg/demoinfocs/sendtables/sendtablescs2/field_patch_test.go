@@ -0,0 +1,185 @@
+package sendtablescs2
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+func TestFieldPatch_ShouldApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		minBuild uint32
+		maxBuild uint32
+		build    uint32
+		apply    bool
+	}{
+		{"no limits", 0, 0, 10000, true},
+		{"no limits, unknown build", 0, 0, 0, true},
+		{"below min", 1000, 0, 999, false},
+		{"at min", 1000, 0, 1000, true},
+		{"above min", 1000, 0, 1001, true},
+		{"below max", 0, 1000, 999, true},
+		{"at max", 0, 1000, 1000, true},
+		{"above max", 0, 1000, 1001, false},
+		{"within range", 1000, 2000, 1500, true},
+		{"below range", 1000, 2000, 999, false},
+		{"above range", 1000, 2000, 2001, false},
+		{"range, unknown build", 1000, 2000, 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := fieldPatch{minBuild: test.minBuild, maxBuild: test.maxBuild}
+
+			assert.Equal(t, test.apply, p.shouldApply(test.build))
+		})
+	}
+}
+
+// decoderName returns the name of a decoder function, e.g. "noscaleDecoder".
+func decoderName(d fieldDecoder) string {
+	name := runtime.FuncForPC(reflect.ValueOf(d).Pointer()).Name()
+
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// flattenedSerializerPacket returns a packet for ParsePacket() with a single serializer 'CFoo'
+// that contains a float32 field for each name.
+func flattenedSerializerPacket(t *testing.T, fieldNames ...string) []byte {
+	t.Helper()
+
+	m := &msg.CSVCMsg_FlattenedSerializer{
+		Symbols: []string{"CFoo", "float32"},
+		Serializers: []*msg.ProtoFlattenedSerializerT{{
+			SerializerNameSym: proto.Int32(0),
+			SerializerVersion: proto.Int32(0),
+		}},
+	}
+
+	for i, name := range fieldNames {
+		m.Symbols = append(m.Symbols, name)
+		m.Fields = append(m.Fields, &msg.ProtoFlattenedSerializerFieldT{
+			VarNameSym: proto.Int32(int32(len(m.Symbols) - 1)),
+			VarTypeSym: proto.Int32(1),
+		})
+		m.Serializers[0].FieldsIndex = append(m.Serializers[0].FieldsIndex, int32(i))
+	}
+
+	b, err := proto.Marshal(m)
+	require.NoError(t, err)
+
+	return append(protowire.AppendVarint(nil, uint64(len(b))), b...)
+}
+
+func TestParser_ParsePacket_FieldPatches(t *testing.T) {
+	renameTo := func(name string) func(*st.FieldDefinition) {
+		return func(def *st.FieldDefinition) {
+			if def.VarName == "m_flFoo" {
+				def.VarName = name
+			}
+		}
+	}
+
+	tests := []struct {
+		name    string
+		build   uint32
+		patches []st.FieldPatch
+		field   string // Name of the field in the packet
+		varName string // Name of the field after patching, defaults to field
+		decoder string
+	}{
+		{
+			name:    "no patch",
+			field:   "m_flFoo",
+			decoder: "noscaleDecoder",
+		},
+		{
+			name:    "built-in patch",
+			field:   "m_flSimulationTime",
+			decoder: "simulationTimeDecoder",
+		},
+		{
+			name: "encoder",
+			patches: []st.FieldPatch{{Patch: func(def *st.FieldDefinition) {
+				def.Encoder = "runetime"
+			}}},
+			field:   "m_flFoo",
+			decoder: "runeTimeDecoder",
+		},
+		{
+			name: "var type",
+			patches: []st.FieldPatch{{Patch: func(def *st.FieldDefinition) {
+				def.VarType = "bool"
+			}}},
+			field:   "m_flFoo",
+			decoder: "booleanDecoder",
+		},
+		{
+			name:  "build within range",
+			build: 1500,
+			patches: []st.FieldPatch{{MinBuild: 1000, MaxBuild: 2000, Patch: func(def *st.FieldDefinition) {
+				def.Encoder = "runetime"
+			}}},
+			field:   "m_flFoo",
+			decoder: "runeTimeDecoder",
+		},
+		{
+			name:  "build out of range",
+			build: 2001,
+			patches: []st.FieldPatch{{MinBuild: 1000, MaxBuild: 2000, Patch: func(def *st.FieldDefinition) {
+				def.Encoder = "runetime"
+			}}},
+			field:   "m_flFoo",
+			decoder: "noscaleDecoder",
+		},
+		{
+			name:    "applied after built-in patches",
+			patches: []st.FieldPatch{{Patch: renameTo("m_flSimulationTime")}},
+			field:   "m_flFoo",
+			varName: "m_flSimulationTime",
+			decoder: "noscaleDecoder",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := NewParser(nil)
+			p.SetGameBuild(test.build)
+			p.AddFieldPatches(test.patches...)
+
+			err := p.ParsePacket(flattenedSerializerPacket(t, test.field))
+			require.NoError(t, err)
+
+			ser := p.serializers["CFoo"]
+			require.NotNil(t, ser)
+			require.Len(t, ser.fields, 1)
+
+			varName := test.varName
+			if varName == "" {
+				varName = test.field
+			}
+
+			f := ser.fields[0]
+			assert.Equal(t, varName, f.varName)
+			assert.Equal(t, test.decoder, decoderName(f.decoder))
+		})
+	}
+}
+
+func TestParser_AddFieldPatches_DoesNotModifyBuiltIns(t *testing.T) {
+	p := NewParser(nil)
+	p.AddFieldPatches(st.FieldPatch{Patch: func(*st.FieldDefinition) {}})
+
+	assert.Len(t, fieldPatches, 1)
+	assert.Len(t, p.fieldPatches, 2)
+}
@@ -3,6 +3,8 @@ package sendtablescs2
 import (
 	"fmt"
 	"math"
+	"path"
+	"slices"
 	"strings"
 
 	"google.golang.org/protobuf/proto"
@@ -68,6 +70,11 @@ type Parser struct {
 	pathCache                   []*fieldPath
 	tuplesCache                 []tuple
 	packetEntitiesPanicWarnFunc func(error)
+	trackChangedProperties      bool
+	propertyChanges             *propertyChangeSubscriptions
+	filter                      *entityFilter
+	gameBuild                   uint32
+	fieldPatches                []fieldPatch
 }
 
 func (p *Parser) ReadEnterPVS(r *bit.BitReader, index int, entities map[int]st.Entity, slot int) st.Entity {
@@ -115,9 +122,66 @@ func NewParser(packetEntitiesPanicWarnFunc func(error)) *Parser {
 		classesByName:               make(map[string]*class),
 		entities:                    make(map[int32]*Entity),
 		packetEntitiesPanicWarnFunc: packetEntitiesPanicWarnFunc,
+		propertyChanges:             new(propertyChangeSubscriptions),
+		fieldPatches:                fieldPatches,
 	}
 }
 
+// SetGameBuild sets the game build of the demo (CDemoFileHeader.build_num).
+// Field patches are only applied if the build is within their range.
+//
+// Must be called before ParsePacket().
+func (p *Parser) SetGameBuild(build uint32) {
+	p.gameBuild = build
+}
+
+// AddFieldPatches adds patches that are applied to field definitions (after the built-in patches).
+//
+// Must be called before ParsePacket().
+func (p *Parser) AddFieldPatches(patches ...st.FieldPatch) {
+	p.fieldPatches = slices.Clip(p.fieldPatches)
+
+	for _, patch := range patches {
+		p.fieldPatches = append(p.fieldPatches, newUserFieldPatch(patch))
+	}
+}
+
+// SetEntityFilter restricts which entities and fields are decoded.
+// includeClass is called once per server-class and includeField once per server-class and field name, either may be nil.
+// Entities of excluded classes are still created and destroyed, but none of their fields are decoded.
+// Excluded fields are skipped without being stored and won't trigger any update handlers.
+//
+// Must be called before OnDemoClassInfo().
+func (p *Parser) SetEntityFilter(includeClass func(className string) bool, includeField func(className, fieldName string) bool) {
+	p.filter = &entityFilter{
+		includeClass: includeClass,
+		includeField: includeField,
+	}
+}
+
+// OnPropertyChange registers a handler that is called whenever a property matching propPattern
+// of an entity with a server-class matching classPattern changes.
+// Patterns use the syntax of path.Match(), e.g. "m_pWeaponServices.m_hMyWeapons.*".
+//
+// Returns path.ErrBadPattern if one of the patterns is malformed.
+func (p *Parser) OnPropertyChange(classPattern, propPattern string, handler st.PropertyChangeHandler) error {
+	if _, err := path.Match(classPattern, ""); err != nil {
+		return err
+	}
+
+	if _, err := path.Match(propPattern, ""); err != nil {
+		return err
+	}
+
+	p.propertyChanges.add(propertyChangeSubscription{
+		classPattern: classPattern,
+		propPattern:  propPattern,
+		handler:      handler,
+	})
+
+	return nil
+}
+
 // Internal callback for OnCSVCMsg_ServerInfo.
 func (p *Parser) OnServerInfo(m *msg.CSVCMsg_ServerInfo) error {
 	// This may be needed to parse PacketEntities.
@@ -138,6 +202,8 @@ func (p *Parser) OnDemoClassInfo(m *msg.CDemoClassInfo) error {
 			fpNameCache: &fpNameTreeCache{
 				next: make(map[int]*fpNameTreeCache),
 			},
+			propertyChanges: p.propertyChanges,
+			filter:          p.filter,
 		}
 		p.classesById[class.classId] = class
 		p.classesByName[class.name] = class
@@ -185,6 +251,13 @@ func (p *Parser) ParsePacket(b []byte) error {
 				//	field.parentName = serializer.name
 				//}
 
+				// apply any build-specific patches to the field
+				for _, h := range p.fieldPatches {
+					if h.shouldApply(p.gameBuild) {
+						h.patch(field)
+					}
+				}
+
 				// find or create a field type
 				if _, ok := fieldTypes[field.varType]; !ok {
 					fieldTypes[field.varType] = newFieldType(field.varType)
@@ -196,11 +269,6 @@ func (p *Parser) ParsePacket(b []byte) error {
 					field.serializer = p.serializers[field.serializerName]
 				}
 
-				// apply any build-specific patches to the field
-				for _, h := range fieldPatches {
-					h.patch(field)
-				}
-
 				// determine field model
 				if field.serializer != nil {
 					if field.fieldType.pointer || pointerTypes[field.fieldType.baseType] {
@@ -64,6 +64,29 @@ func (r *reader) readBits(n uint32) uint32 {
 	return uint32(x)
 }
 
+// skipBits skips the given number of sequential bits
+func (r *reader) skipBits(n uint32) {
+	if n <= r.bitCount {
+		r.bitVal >>= n
+		r.bitCount -= n
+
+		return
+	}
+
+	n -= r.bitCount
+	r.bitVal = 0
+	r.bitCount = 0
+	r.pos += n / 8
+
+	if r.pos > r.size {
+		_panicf("skipBits: insufficient buffer (%d of %d)", r.pos, r.size)
+	}
+
+	if rem := n % 8; rem > 0 {
+		r.readBits(rem)
+	}
+}
+
 // readByte reads a single byte
 func (r *reader) readByte() byte {
 	// Fast path if we're byte aligned
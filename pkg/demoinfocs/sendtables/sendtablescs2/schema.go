@@ -0,0 +1,68 @@
+package sendtablescs2
+
+import (
+	"sort"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+// Schema returns the schema of all server-classes, sorted by name.
+// Fields of nested tables are flattened into their parent class, see sendtables.FieldSchema.
+func (sc *serverClasses) Schema() st.Schema {
+	schema := st.Schema{
+		Classes: make([]st.ClassSchema, 0, len(sc.classesById)),
+	}
+
+	for _, c := range sc.classesById {
+		schema.Classes = append(schema.Classes, st.ClassSchema{
+			ID:         c.ID(),
+			Name:       c.name,
+			Serializer: c.serializer.name,
+			Fields:     sc.collectFieldSchemas(nil, c.serializer, ""),
+		})
+	}
+
+	sort.Slice(schema.Classes, func(i, j int) bool {
+		return schema.Classes[i].Name < schema.Classes[j].Name
+	})
+
+	return schema
+}
+
+// collectFieldSchemas appends the schemas of all fields of the serializer, including the fields of nested tables.
+// The declared serializer of table fields is used (f.serializer of polymorphic tables changes while decoding).
+func (sc *serverClasses) collectFieldSchemas(schemas []st.FieldSchema, ser *serializer, prefix string) []st.FieldSchema {
+	for _, f := range ser.fields {
+		fs := f.schema(prefix)
+		schemas = append(schemas, fs)
+
+		tableSer := sc.serializers[f.serializerName]
+		if tableSer == nil {
+			continue
+		}
+
+		switch f.model {
+		case fieldModelFixedTable:
+			schemas = sc.collectFieldSchemas(schemas, tableSer, fs.Name+".")
+
+		case fieldModelVariableTable:
+			schemas = sc.collectFieldSchemas(schemas, tableSer, fs.Name+".*.")
+		}
+	}
+
+	return schemas
+}
+
+func (f *field) schema(prefix string) st.FieldSchema {
+	return st.FieldSchema{
+		Name:        prefix + f.varName,
+		VarType:     f.varType,
+		Encoder:     f.encoder,
+		EncodeFlags: f.encodeFlags,
+		BitCount:    f.bitCount,
+		LowValue:    f.lowValue,
+		HighValue:   f.highValue,
+		Model:       f.modelString(),
+		Serializer:  f.serializerName,
+	}
+}
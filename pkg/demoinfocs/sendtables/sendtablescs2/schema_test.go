@@ -0,0 +1,80 @@
+package sendtablescs2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+func TestServerClasses_Schema(t *testing.T) {
+	weaponServices := &serializer{
+		name: "CCSPlayer_WeaponServices",
+		fields: []*field{
+			{varName: "m_hActiveWeapon", varType: "CHandle< CBasePlayerWeapon >", model: fieldModelSimple},
+		},
+	}
+	item := &serializer{
+		name: "CEconItemAttribute",
+		fields: []*field{
+			{varName: "m_flValue", varType: "float32", model: fieldModelSimple},
+		},
+	}
+	pawn := &serializer{
+		name: "CCSPlayerPawn",
+		fields: []*field{
+			{varName: "m_iHealth", varType: "int32", model: fieldModelSimple},
+			{varName: "m_vecOrigin", varType: "Vector", encoder: "coord", bitCount: ptr(int32(0)), model: fieldModelSimple},
+			{varName: "m_iAmmo", varType: "uint16[32]", model: fieldModelFixedArray},
+			{varName: "m_pWeaponServices", varType: "CCSPlayer_WeaponServices*", serializerName: weaponServices.name, model: fieldModelFixedTable},
+			{varName: "m_Attributes", varType: "CUtlVectorEmbeddedNetworkVar< CEconItemAttribute >", serializerName: item.name, model: fieldModelVariableTable},
+		},
+	}
+	team := &serializer{
+		name: "CCSTeam",
+		fields: []*field{
+			{varName: "m_iScore", varType: "int32", model: fieldModelSimple},
+		},
+	}
+
+	p := &Parser{
+		serializers: map[string]*serializer{
+			pawn.name:           pawn,
+			weaponServices.name: weaponServices,
+			item.name:           item,
+			team.name:           team,
+		},
+		classesById: map[int32]*class{
+			2: {classId: 2, name: "CCSTeam", serializer: team},
+			1: {classId: 1, name: "CCSPlayerPawn", serializer: pawn},
+		},
+	}
+
+	schema := p.ServerClasses().Schema()
+
+	assert.Equal(t, st.Schema{Classes: []st.ClassSchema{
+		{
+			ID:         1,
+			Name:       "CCSPlayerPawn",
+			Serializer: "CCSPlayerPawn",
+			Fields: []st.FieldSchema{
+				{Name: "m_iHealth", VarType: "int32", Model: "simple"},
+				{Name: "m_vecOrigin", VarType: "Vector", Encoder: "coord", BitCount: ptr(int32(0)), Model: "simple"},
+				{Name: "m_iAmmo", VarType: "uint16[32]", Model: "fixed-array"},
+				{Name: "m_pWeaponServices", VarType: "CCSPlayer_WeaponServices*", Model: "fixed-table", Serializer: "CCSPlayer_WeaponServices"},
+				{Name: "m_pWeaponServices.m_hActiveWeapon", VarType: "CHandle< CBasePlayerWeapon >", Model: "simple"},
+				{Name: "m_Attributes", VarType: "CUtlVectorEmbeddedNetworkVar< CEconItemAttribute >", Model: "variable-table", Serializer: "CEconItemAttribute"},
+				{Name: "m_Attributes.*.m_flValue", VarType: "float32", Model: "simple"},
+			},
+		},
+		{
+			ID:         2,
+			Name:       "CCSTeam",
+			Serializer: "CCSTeam",
+			Fields: []st.FieldSchema{
+				{Name: "m_iScore", VarType: "int32", Model: "simple"},
+			},
+		},
+	}}, schema)
+}
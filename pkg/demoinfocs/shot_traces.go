@@ -0,0 +1,175 @@
+package demoinfocs
+
+import (
+	"github.com/golang/geo/r3"
+
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+)
+
+const (
+	// Maximum distance between a shooter's eyes and a muzzle flash for it to belong to the shooter.
+	maxMuzzleFlashDistance = 96
+	// Maximum angle (in degrees) between a shooter's view direction and an impact for it to belong to the shot.
+	// Needs to account for spread, recoil and view-punch.
+	maxShotTraceImpactAngle = 20
+)
+
+// shotTraceTracker collects shots, hits and temp-entities of the current frame
+// so they can be correlated into ShotTrace events at the end of the frame.
+type shotTraceTracker struct {
+	shots         []*events.ShotTrace
+	hurts         []events.PlayerHurt
+	impacts       []r3.Vector
+	muzzleFlashes []r3.Vector
+}
+
+func (t *shotTraceTracker) reset() {
+	t.shots = t.shots[:0]
+	t.hurts = t.hurts[:0]
+	t.impacts = t.impacts[:0]
+	t.muzzleFlashes = t.muzzleFlashes[:0]
+}
+
+func (p *parser) trackShotTraceWeaponFire(e events.WeaponFire) {
+	if e.Shooter == nil || e.Weapon == nil || !e.Weapon.IsFirearm() {
+		return
+	}
+
+	p.shotTraces.shots = append(p.shotTraces.shots, &events.ShotTrace{
+		Shooter: e.Shooter,
+		Weapon:  e.Weapon,
+	})
+}
+
+func (p *parser) trackShotTracePlayerHurt(e events.PlayerHurt) {
+	if e.Attacker == nil || e.Player == nil {
+		return
+	}
+
+	p.shotTraces.hurts = append(p.shotTraces.hurts, e)
+}
+
+func msgVectorToR3(v *msg.CMsgVector) r3.Vector {
+	return r3.Vector{
+		X: float64(v.GetX()),
+		Y: float64(v.GetY()),
+		Z: float64(v.GetZ()),
+	}
+}
+
+func (p *parser) handleTEImpact(m *msg.CMsgTEImpact) {
+	if m.Origin != nil {
+		p.shotTraces.impacts = append(p.shotTraces.impacts, msgVectorToR3(m.Origin))
+	}
+}
+
+func (p *parser) handleTEArmorRicochet(m *msg.CMsgTEArmorRicochet) {
+	if m.Pos != nil {
+		p.shotTraces.impacts = append(p.shotTraces.impacts, msgVectorToR3(m.Pos))
+	}
+}
+
+func (p *parser) handleTEBloodStream(m *msg.CMsgTEBloodStream) {
+	if m.Origin != nil {
+		p.shotTraces.impacts = append(p.shotTraces.impacts, msgVectorToR3(m.Origin))
+	}
+}
+
+func (p *parser) handleTEEffectDispatch(m *msg.CMsgTEEffectDispatch) {
+	data := m.GetEffectdata()
+
+	// only effects with a trace start (e.g. bullet impacts) have their origin at the end of a shot
+	if data.GetStart() == nil || data.GetOrigin() == nil {
+		return
+	}
+
+	p.shotTraces.impacts = append(p.shotTraces.impacts, msgVectorToR3(data.GetOrigin()))
+}
+
+func (p *parser) handleTEMuzzleFlash(m *msg.CMsgTEMuzzleFlash) {
+	if m.Origin != nil {
+		p.shotTraces.muzzleFlashes = append(p.shotTraces.muzzleFlashes, msgVectorToR3(m.Origin))
+	}
+}
+
+// processShotTraces correlates the shots, hits and temp-entities of the current frame and dispatches ShotTrace events.
+func (p *parser) processShotTraces() {
+	t := &p.shotTraces
+	defer t.reset()
+
+	if len(t.shots) == 0 {
+		return
+	}
+
+	directions := make([]r3.Vector, len(t.shots))
+
+	for i, shot := range t.shots {
+		if shot.Shooter.PlayerPawnEntity() == nil {
+			continue
+		}
+
+		shot.Origin = shot.Shooter.PositionEyes()
+		directions[i] = shot.Shooter.ViewDirection()
+
+		if flash, ok := closestPoint(t.muzzleFlashes, shot.Origin); ok && flash.Distance(shot.Origin) <= maxMuzzleFlashDistance {
+			shot.Origin = flash
+		}
+	}
+
+	for _, impact := range t.impacts {
+		best := -1
+		bestAngle := float64(maxShotTraceImpactAngle)
+
+		for i, shot := range t.shots {
+			if directions[i] == (r3.Vector{}) {
+				continue
+			}
+
+			angle := directions[i].Angle(impact.Sub(shot.Origin)).Degrees()
+			if angle <= bestAngle {
+				best, bestAngle = i, angle
+			}
+		}
+
+		if best >= 0 {
+			t.shots[best].ImpactPoints = append(t.shots[best].ImpactPoints, impact)
+		}
+	}
+
+	for _, hurt := range t.hurts {
+		for _, shot := range t.shots {
+			if shot.HitPlayer != nil || shot.Shooter != hurt.Attacker {
+				continue
+			}
+
+			if hurt.Weapon != nil && hurt.Weapon.Type != shot.Weapon.Type {
+				continue
+			}
+
+			shot.HitPlayer = hurt.Player
+			shot.HitGroup = hurt.HitGroup
+
+			break
+		}
+	}
+
+	for _, shot := range t.shots {
+		p.eventDispatcher.Dispatch(*shot)
+	}
+}
+
+func closestPoint(points []r3.Vector, target r3.Vector) (r3.Vector, bool) {
+	var (
+		closest r3.Vector
+		found   bool
+	)
+
+	for _, point := range points {
+		if !found || point.Distance(target) < closest.Distance(target) {
+			closest, found = point, true
+		}
+	}
+
+	return closest, found
+}
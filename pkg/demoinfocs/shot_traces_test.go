@@ -0,0 +1,74 @@
+package demoinfocs
+
+import (
+	"testing"
+
+	"github.com/golang/geo/r3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	events "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/events"
+	msg "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
+)
+
+func newShooter(p *parser, pawnID int, pos r3.Vector) *common.Player {
+	pawn := new(stfake.Entity)
+	pawn.On("Position").Return(pos)
+	pawn.On("PropertyValueMust", "m_fFlags").Return(st.PropertyValue{Any: uint64(0)})
+	pawn.On("PropertyValueMust", "m_angEyeAngles").Return(st.PropertyValue{Any: []float32{0, 0, 0}})
+	p.gameState.entities[pawnID] = pawn
+
+	controller := new(stfake.Entity)
+	controller.On("PropertyValue", "m_hPawn").Return(st.PropertyValue{Any: uint64(pawnID)}, true)
+	controller.On("PropertyValue", "m_hPlayerPawn").Return(st.PropertyValue{Any: uint64(pawnID)}, true)
+
+	pl := common.NewPlayer(demoInfoProvider{parser: p})
+	pl.Entity = controller
+
+	return pl
+}
+
+func TestShotTrace(t *testing.T) {
+	p := newParser()
+	shooter := newShooter(p, 10, r3.Vector{})
+	victim := newPlayer()
+	ak := common.NewEquipment(common.EqAK47)
+
+	var traces []events.ShotTrace
+	p.RegisterEventHandler(func(e events.ShotTrace) {
+		traces = append(traces, e)
+	})
+
+	p.eventDispatcher.Dispatch(events.WeaponFire{Shooter: shooter, Weapon: ak})
+	p.eventDispatcher.Dispatch(events.WeaponFire{Shooter: shooter, Weapon: common.NewEquipment(common.EqKnife)})
+	p.eventDispatcher.Dispatch(events.PlayerHurt{
+		Player:   victim,
+		Attacker: shooter,
+		Weapon:   ak,
+		HitGroup: events.HitGroupHead,
+	})
+
+	p.handleTEMuzzleFlash(&msg.CMsgTEMuzzleFlash{Origin: &msg.CMsgVector{X: proto.Float32(20), Z: proto.Float32(60)}})
+	p.handleTEImpact(&msg.CMsgTEImpact{Origin: &msg.CMsgVector{X: proto.Float32(500), Y: proto.Float32(10), Z: proto.Float32(64)}})
+	p.handleTEBloodStream(&msg.CMsgTEBloodStream{Origin: &msg.CMsgVector{X: proto.Float32(300), Z: proto.Float32(70)}})
+	p.handleTEImpact(&msg.CMsgTEImpact{Origin: &msg.CMsgVector{X: proto.Float32(-500)}}) // behind the shooter
+
+	p.processFrameGameEvents()
+
+	assert.Equal(t, []events.ShotTrace{{
+		Shooter:      shooter,
+		Weapon:       ak,
+		Origin:       r3.Vector{X: 20, Z: 60},
+		ImpactPoints: []r3.Vector{{X: 500, Y: 10, Z: 64}, {X: 300, Z: 70}},
+		HitPlayer:    victim,
+		HitGroup:     events.HitGroupHead,
+	}}, traces)
+
+	traces = nil
+	p.processFrameGameEvents()
+
+	assert.Empty(t, traces)
+}
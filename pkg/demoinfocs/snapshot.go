@@ -0,0 +1,350 @@
+package demoinfocs
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/golang/geo/r3"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+)
+
+// Snapshot is an immutable view of the game world at a specific tick, see Parser.Snapshot().
+// It stays valid after parsing continues and is safe to use from other goroutines.
+//
+// Snapshots share unchanged entities with previous snapshots, so none of the contained values must be modified.
+type Snapshot struct {
+	IngameTick int
+	Frame      int
+
+	Entities  map[int]*EntitySnapshot // Entity ID -> entity
+	Players   []PlayerSnapshot
+	Grenades  []GrenadeSnapshot
+	GameRules GameRulesSnapshot
+}
+
+// EntitySnapshot is an immutable view of an entity and all of its properties.
+type EntitySnapshot struct {
+	ID          int
+	SerialNum   int
+	ServerClass string
+	Properties  map[string]any // Property name -> value, see st.PropertyValue.Any
+}
+
+// PlayerSnapshot contains the state of a player at the time of a snapshot.
+type PlayerSnapshot struct {
+	SteamID64      uint64
+	UserID         int
+	EntityID       int
+	Name           string
+	Team           common.Team
+	IsBot          bool
+	IsConnected    bool
+	IsAlive        bool
+	Health         int
+	Armor          int
+	Money          int
+	Position       r3.Vector
+	ViewDirectionX float32
+	ViewDirectionY float32
+	FlashDuration  float32
+	ActiveWeapon   common.EquipmentType // EqUnknown if the player doesn't have a weapon equipped
+	HasDefuseKit   bool
+	HasHelmet      bool
+	IsDefusing     bool
+	IsPlanting     bool
+}
+
+// GrenadeSnapshot contains the state of a grenade projectile at the time of a snapshot.
+type GrenadeSnapshot struct {
+	UniqueID         int64
+	EntityID         int
+	Type             common.EquipmentType
+	ThrowerSteamID64 uint64 // 0 if the thrower is unknown or a bot
+	ThrowerUserID    int    // 0 if the thrower is unknown
+	Position         r3.Vector
+}
+
+// GameRulesSnapshot contains the state of the game rules and the scores at the time of a snapshot.
+type GameRulesSnapshot struct {
+	TotalRoundsPlayed int
+	GamePhase         common.GamePhase
+	IsWarmupPeriod    bool
+	IsFreezetime      bool
+	IsMatchStarted    bool
+	OvertimeCount     int
+	ScoreCT           int
+	ScoreT            int
+}
+
+// versionedEntity is implemented by entities that can tell whether they changed (sendtablescs2.Entity).
+type versionedEntity interface {
+	Version() uint64
+	Map() map[string]any
+}
+
+type entitySnapshotCacheEntry struct {
+	entity   st.Entity
+	version  uint64
+	snapshot *EntitySnapshot
+}
+
+// Snapshot returns an immutable snapshot of all entities, players, grenades and the game rules.
+// Entities that haven't changed since the previous snapshot are shared with it instead of being copied,
+// which makes it cheap to take a snapshot every few ticks.
+//
+// See also: Diff()
+func (p *parser) Snapshot() *Snapshot {
+	gs := p.gameState
+
+	snap := &Snapshot{
+		IngameTick: gs.IngameTick(),
+		Frame:      p.CurrentFrame(),
+		Entities:   make(map[int]*EntitySnapshot, len(gs.entities)),
+		GameRules: GameRulesSnapshot{
+			TotalRoundsPlayed: gs.TotalRoundsPlayed(),
+			GamePhase:         gs.GamePhase(),
+			IsWarmupPeriod:    gs.IsWarmupPeriod(),
+			IsFreezetime:      gs.IsFreezetimePeriod(),
+			IsMatchStarted:    gs.IsMatchStarted(),
+			OvertimeCount:     gs.OvertimeCount(),
+			ScoreCT:           gs.TeamCounterTerrorists().Score(),
+			ScoreT:            gs.TeamTerrorists().Score(),
+		},
+	}
+
+	cache := make(map[int]entitySnapshotCacheEntry, len(gs.entities))
+
+	for id, entity := range gs.entities {
+		if entity == nil {
+			continue
+		}
+
+		entry := p.snapshotEntity(entity)
+		cache[id] = entry
+		snap.Entities[id] = entry.snapshot
+	}
+
+	p.entitySnapshotCache = cache
+
+	for _, pl := range gs.Participants().All() {
+		snap.Players = append(snap.Players, newPlayerSnapshot(pl))
+	}
+
+	sort.Slice(snap.Players, func(i, j int) bool {
+		return snap.Players[i].UserID < snap.Players[j].UserID
+	})
+
+	for id, proj := range gs.GrenadeProjectiles() {
+		snap.Grenades = append(snap.Grenades, newGrenadeSnapshot(id, proj))
+	}
+
+	sort.Slice(snap.Grenades, func(i, j int) bool {
+		return snap.Grenades[i].EntityID < snap.Grenades[j].EntityID
+	})
+
+	return snap
+}
+
+// snapshotEntity returns the cached snapshot of the entity if it didn't change since the previous snapshot
+// or creates a new one otherwise.
+func (p *parser) snapshotEntity(entity st.Entity) entitySnapshotCacheEntry {
+	versioned, isVersioned := entity.(versionedEntity)
+
+	var version uint64
+	if isVersioned {
+		version = versioned.Version()
+
+		cached, ok := p.entitySnapshotCache[entity.ID()]
+		if ok && cached.entity == entity && cached.version == version {
+			return cached
+		}
+	}
+
+	snap := &EntitySnapshot{
+		ID:          entity.ID(),
+		SerialNum:   entity.SerialNum(),
+		ServerClass: entity.ServerClass().Name(),
+	}
+
+	if isVersioned {
+		snap.Properties = versioned.Map()
+	} else {
+		props := entity.Properties()
+		snap.Properties = make(map[string]any, len(props))
+
+		for _, prop := range props {
+			snap.Properties[prop.Name()] = prop.Value().Any
+		}
+	}
+
+	return entitySnapshotCacheEntry{
+		entity:   entity,
+		version:  version,
+		snapshot: snap,
+	}
+}
+
+func newPlayerSnapshot(pl *common.Player) PlayerSnapshot {
+	snap := PlayerSnapshot{
+		SteamID64:     pl.SteamID64,
+		UserID:        pl.UserID,
+		EntityID:      pl.EntityID,
+		Name:          pl.Name,
+		Team:          pl.Team,
+		IsBot:         pl.IsBot,
+		IsConnected:   pl.IsConnected,
+		FlashDuration: pl.FlashDuration,
+		IsDefusing:    pl.IsDefusing,
+		IsPlanting:    pl.IsPlanting,
+		ActiveWeapon:  common.EqUnknown,
+	}
+
+	// most getters need the controller entity
+	if pl.Entity == nil {
+		return snap
+	}
+
+	snap.IsAlive = pl.IsAlive()
+	snap.Health = pl.Health()
+	snap.Armor = pl.Armor()
+	snap.Money = pl.Money()
+	snap.Position = pl.Position()
+	snap.ViewDirectionX = pl.ViewDirectionX()
+	snap.ViewDirectionY = pl.ViewDirectionY()
+	snap.HasDefuseKit = pl.HasDefuseKit()
+	snap.HasHelmet = pl.HasHelmet()
+
+	if wep := pl.ActiveWeapon(); wep != nil {
+		snap.ActiveWeapon = wep.Type
+	}
+
+	return snap
+}
+
+func newGrenadeSnapshot(entityID int, proj *common.GrenadeProjectile) GrenadeSnapshot {
+	snap := GrenadeSnapshot{
+		UniqueID: proj.UniqueID(),
+		EntityID: entityID,
+		Type:     common.EqUnknown,
+	}
+
+	if proj.WeaponInstance != nil {
+		snap.Type = proj.WeaponInstance.Type
+	}
+
+	if proj.Thrower != nil {
+		snap.ThrowerSteamID64 = proj.Thrower.SteamID64
+		snap.ThrowerUserID = proj.Thrower.UserID
+	}
+
+	if proj.Entity != nil {
+		snap.Position = proj.Position()
+	}
+
+	return snap
+}
+
+// SnapshotDiff contains the differences between two snapshots, see Diff().
+type SnapshotDiff struct {
+	Created   []int        // IDs of entities that only exist in the newer snapshot
+	Destroyed []int        // IDs of entities that only exist in the older snapshot
+	Changed   []EntityDiff // Entities that exist in both snapshots but have changed properties
+}
+
+// EntityDiff lists the properties of an entity that changed between two snapshots.
+// Use the snapshots to look up the old and new values.
+type EntityDiff struct {
+	ID          int
+	ServerClass string
+	Properties  []string // Names of changed, added or removed properties, sorted
+}
+
+// IsEmpty returns true if no entities were created, destroyed or changed.
+func (d SnapshotDiff) IsEmpty() bool {
+	return len(d.Created) == 0 && len(d.Destroyed) == 0 && len(d.Changed) == 0
+}
+
+// Diff returns the entities and properties that changed between the snapshots prev and next.
+// An entity ID that was reused by a different entity (different server-class or serial number)
+// is reported as destroyed and created.
+// Entities that are shared between the snapshots (i.e. didn't change) are skipped cheaply.
+func Diff(prev, next *Snapshot) SnapshotDiff {
+	var d SnapshotDiff
+
+	for id, prevEnt := range prev.Entities {
+		nextEnt, ok := next.Entities[id]
+		if !ok || nextEnt.SerialNum != prevEnt.SerialNum || nextEnt.ServerClass != prevEnt.ServerClass {
+			d.Destroyed = append(d.Destroyed, id)
+
+			if ok {
+				d.Created = append(d.Created, id)
+			}
+
+			continue
+		}
+
+		if nextEnt == prevEnt {
+			continue
+		}
+
+		if props := changedProperties(prevEnt.Properties, nextEnt.Properties); len(props) > 0 {
+			d.Changed = append(d.Changed, EntityDiff{
+				ID:          id,
+				ServerClass: nextEnt.ServerClass,
+				Properties:  props,
+			})
+		}
+	}
+
+	for id := range next.Entities {
+		if _, ok := prev.Entities[id]; !ok {
+			d.Created = append(d.Created, id)
+		}
+	}
+
+	slices.Sort(d.Created)
+	slices.Sort(d.Destroyed)
+	sort.Slice(d.Changed, func(i, j int) bool {
+		return d.Changed[i].ID < d.Changed[j].ID
+	})
+
+	return d
+}
+
+func changedProperties(prev, next map[string]any) []string {
+	var changed []string
+
+	for name, prevVal := range prev {
+		nextVal, ok := next[name]
+		if !ok || !valuesEqual(prevVal, nextVal) {
+			changed = append(changed, name)
+		}
+	}
+
+	for name := range next {
+		if _, ok := prev[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+func valuesEqual(a, b any) bool {
+	switch aVal := a.(type) {
+	case []float32:
+		bVal, ok := b.([]float32)
+
+		return ok && slices.Equal(aVal, bVal)
+
+	case int32, uint32, uint64, int64, float32, bool, string, nil:
+		return a == b
+	}
+
+	return reflect.DeepEqual(a, b)
+}
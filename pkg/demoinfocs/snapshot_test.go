@@ -0,0 +1,104 @@
+package demoinfocs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	st "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables"
+	stfake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/sendtables/fake"
+)
+
+type namedServerClass struct {
+	st.ServerClass
+	name string
+}
+
+func (sc namedServerClass) Name() string {
+	return sc.name
+}
+
+type versionedFakeEntity struct {
+	*stfake.Entity
+	version uint64
+	props   map[string]any
+}
+
+func (e *versionedFakeEntity) Version() uint64 {
+	return e.version
+}
+
+func (e *versionedFakeEntity) Map() map[string]any {
+	props := make(map[string]any, len(e.props))
+
+	for k, v := range e.props {
+		props[k] = v
+	}
+
+	return props
+}
+
+func newVersionedFakeEntity(id int, className string, props map[string]any) *versionedFakeEntity {
+	entity := new(stfake.Entity)
+	entity.On("ID").Return(id)
+	entity.On("SerialNum").Return(1)
+	entity.On("ServerClass").Return(namedServerClass{name: className})
+
+	return &versionedFakeEntity{Entity: entity, props: props}
+}
+
+func TestParser_Snapshot(t *testing.T) {
+	p := newParser()
+
+	pawn := newVersionedFakeEntity(1, "CCSPlayerPawn", map[string]any{"m_iHealth": int32(100), "m_vecOrigin": []float32{1, 2, 3}})
+	team := newVersionedFakeEntity(2, "CCSTeam", map[string]any{"m_iScore": int32(0)})
+	p.gameState.entities[1] = pawn
+	p.gameState.entities[2] = team
+
+	first := p.Snapshot()
+
+	assert.Equal(t, &EntitySnapshot{
+		ID:          1,
+		SerialNum:   1,
+		ServerClass: "CCSPlayerPawn",
+		Properties:  map[string]any{"m_iHealth": int32(100), "m_vecOrigin": []float32{1, 2, 3}},
+	}, first.Entities[1])
+
+	pawn.props = map[string]any{"m_iHealth": int32(73), "m_vecOrigin": []float32{1, 2, 3}}
+	pawn.version++
+
+	delete(p.gameState.entities, 2)
+	p.gameState.entities[3] = newVersionedFakeEntity(3, "CSmokeGrenadeProjectile", nil)
+
+	second := p.Snapshot()
+
+	assert.Equal(t, int32(100), first.Entities[1].Properties["m_iHealth"], "old snapshot must not change")
+	assert.Equal(t, int32(73), second.Entities[1].Properties["m_iHealth"])
+	assert.NotContains(t, p.entitySnapshotCache, 2, "cache must be pruned")
+
+	third := p.Snapshot()
+
+	assert.Same(t, second.Entities[1], third.Entities[1], "unchanged entities must be shared")
+
+	assert.Equal(t, SnapshotDiff{
+		Created:   []int{3},
+		Destroyed: []int{2},
+		Changed: []EntityDiff{{
+			ID:          1,
+			ServerClass: "CCSPlayerPawn",
+			Properties:  []string{"m_iHealth"},
+		}},
+	}, Diff(first, second))
+	assert.True(t, Diff(second, third).IsEmpty())
+}
+
+func TestDiff_ReusedEntityID(t *testing.T) {
+	prev := &Snapshot{Entities: map[int]*EntitySnapshot{
+		5: {ID: 5, SerialNum: 1, ServerClass: "CHEGrenadeProjectile"},
+	}}
+	next := &Snapshot{Entities: map[int]*EntitySnapshot{
+		5: {ID: 5, SerialNum: 2, ServerClass: "CHEGrenadeProjectile"},
+	}}
+
+	assert.Equal(t, SnapshotDiff{Created: []int{5}, Destroyed: []int{5}}, Diff(prev, next))
+}
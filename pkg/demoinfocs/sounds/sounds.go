@@ -0,0 +1,141 @@
+// Package sounds maps CS2 sound event hashes to sound event names.
+//
+// CS2 demos only contain hashes of sound event names (e.g. in svc_Sounds and GE_SosStartSoundEvent messages).
+// The package ships a list of known sound event names (names.txt), the hashes of which are computed on start-up.
+// The list isn't exhaustive, more names can be added with Register().
+//
+// Sound events don't contain their sound level, so names.txt also contains approximate sound levels, see Level().
+package sounds
+
+import (
+	"bufio"
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// hashSeed is the seed that is used for hashing sound event names ("SRC2").
+const hashSeed = 0x53524332
+
+//go:embed names.txt
+var namesTxt string
+
+// DefaultLevel is the sound level (SNDLVL_NORM) of sound events without a known level, see Level().
+const DefaultLevel = 75
+
+var (
+	mu           sync.RWMutex
+	namesByHash  = make(map[uint32]string)
+	levelsByHash = make(map[uint32]int32)
+)
+
+func init() {
+	sc := bufio.NewScanner(strings.NewReader(namesTxt))
+
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// '<name> [<sound level>]'
+		fields := strings.Fields(line)
+		hash := Hash(fields[0])
+		namesByHash[hash] = fields[0]
+
+		if len(fields) > 1 {
+			level, err := strconv.ParseInt(fields[1], 10, 32)
+			if err != nil {
+				panic("invalid sound level in names.txt: " + line)
+			}
+
+			levelsByHash[hash] = int32(level)
+		}
+	}
+}
+
+// Hash returns the hash of a sound event name as it's used in demos.
+// Sound event names are case-insensitive.
+func Hash(name string) uint32 {
+	return murmurHash2([]byte(strings.ToLower(name)), hashSeed)
+}
+
+// Name returns the name of the sound event with the given hash.
+// Returns false if the hash is unknown.
+func Name(hash uint32) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	name, ok := namesByHash[hash]
+
+	return name, ok
+}
+
+// Level returns the approximate sound level (in dB) of the sound event with the given hash.
+// Returns DefaultLevel and false if the level of the sound event isn't known.
+func Level(hash uint32) (int32, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	level, ok := levelsByHash[hash]
+	if !ok {
+		return DefaultLevel, false
+	}
+
+	return level, true
+}
+
+// Register adds sound event names to the lookup table used by Name().
+// Safe for concurrent use.
+func Register(names ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, name := range names {
+		namesByHash[Hash(name)] = name
+	}
+}
+
+// murmurHash2 is Austin Appleby's MurmurHash2 (32-bit).
+func murmurHash2(data []byte, seed uint32) uint32 {
+	const (
+		m = 0x5bd1e995
+		r = 24
+	)
+
+	h := seed ^ uint32(len(data))
+
+	for len(data) >= 4 {
+		k := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16 | uint32(data[3])<<24
+
+		k *= m
+		k ^= k >> r
+		k *= m
+
+		h *= m
+		h ^= k
+
+		data = data[4:]
+	}
+
+	switch len(data) {
+	case 3:
+		h ^= uint32(data[2]) << 16
+
+		fallthrough
+	case 2:
+		h ^= uint32(data[1]) << 8
+
+		fallthrough
+	case 1:
+		h ^= uint32(data[0])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+
+	return h
+}
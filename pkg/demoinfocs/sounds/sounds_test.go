@@ -0,0 +1,54 @@
+package sounds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMurmurHash2(t *testing.T) {
+	assert.Equal(t, uint32(0), murmurHash2(nil, 0))
+	assert.Equal(t, uint32(0xe56129cb), murmurHash2([]byte("hello"), 0))
+	assert.Equal(t, murmurHash2([]byte("abc"), hashSeed), Hash("ABC"))
+}
+
+func TestName(t *testing.T) {
+	name, ok := Name(Hash("weapon_ak47.single"))
+
+	assert.True(t, ok)
+	assert.Equal(t, "Weapon_AK47.Single", name)
+
+	_, ok = Name(Hash("Test.NotRegistered"))
+
+	assert.False(t, ok)
+
+	Register("Test.Registered")
+
+	name, ok = Name(Hash("test.registered"))
+
+	assert.True(t, ok)
+	assert.Equal(t, "Test.Registered", name)
+}
+
+func TestLevel(t *testing.T) {
+	level, ok := Level(Hash("Weapon_AK47.Single"))
+
+	assert.True(t, ok)
+	assert.Equal(t, int32(140), level)
+
+	level, ok = Level(Hash("Weapon_USP.SilencedShot"))
+
+	assert.True(t, ok)
+	assert.Equal(t, int32(90), level)
+
+	// known name without level
+	level, ok = Level(Hash("Default.StepLeft"))
+
+	assert.False(t, ok)
+	assert.Equal(t, int32(DefaultLevel), level)
+
+	level, ok = Level(Hash("Test.NotRegistered"))
+
+	assert.False(t, ok)
+	assert.Equal(t, int32(DefaultLevel), level)
+}
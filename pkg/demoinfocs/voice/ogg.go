@@ -0,0 +1,85 @@
+package voice
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Header type flags of Ogg pages.
+const (
+	oggFlagBOS = 0x02 // First page of the stream
+	oggFlagEOS = 0x04 // Last page of the stream
+)
+
+const oggMaxSegments = 255
+
+// ErrPacketTooLarge is returned if a packet doesn't fit into a single Ogg page.
+var ErrPacketTooLarge = errors.New("packet too large for a single Ogg page")
+
+var oggCRCTable = func() (table [256]uint32) {
+	for i := range table {
+		crc := uint32(i) << 24
+
+		for range 8 {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04c11db7
+			} else {
+				crc <<= 1
+			}
+		}
+
+		table[i] = crc
+	}
+
+	return table
+}()
+
+// oggCRC computes the CRC-32 of Ogg pages (polynomial 0x04c11db7, no reflection, no final XOR).
+func oggCRC(crc uint32, b []byte) uint32 {
+	for _, v := range b {
+		crc = crc<<8 ^ oggCRCTable[byte(crc>>24)^v]
+	}
+
+	return crc
+}
+
+// oggWriter writes a single logical Ogg bitstream with one packet per page.
+type oggWriter struct {
+	w      io.Writer
+	serial uint32
+	seq    uint32
+}
+
+// writePage writes a page containing a single packet.
+// granule is the granule position after the packet, flags a combination of oggFlagBOS and oggFlagEOS.
+func (ow *oggWriter) writePage(packet []byte, granule int64, flags byte) error {
+	nSegments := len(packet)/255 + 1
+	if nSegments > oggMaxSegments {
+		return ErrPacketTooLarge
+	}
+
+	page := make([]byte, 27+nSegments, 27+nSegments+len(packet))
+	copy(page, "OggS")
+	page[5] = flags
+	binary.LittleEndian.PutUint64(page[6:], uint64(granule))
+	binary.LittleEndian.PutUint32(page[14:], ow.serial)
+	binary.LittleEndian.PutUint32(page[18:], ow.seq)
+	page[26] = byte(nSegments)
+
+	// lacing values, a packet that's a multiple of 255 bytes is terminated by a 0
+	for i := range nSegments - 1 {
+		page[27+i] = 255
+	}
+
+	page[27+nSegments-1] = byte(len(packet) % 255)
+
+	page = append(page, packet...)
+	binary.LittleEndian.PutUint32(page[22:], oggCRC(0, page))
+
+	ow.seq++
+
+	_, err := ow.w.Write(page)
+
+	return err
+}
@@ -0,0 +1,83 @@
+package voice
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type oggPage struct {
+	flags   byte
+	granule int64
+	seq     uint32
+	lacing  []byte
+	data    []byte
+}
+
+// readPages parses Ogg pages and verifies their checksums.
+func readPages(t *testing.T, b []byte) []oggPage {
+	t.Helper()
+
+	var pages []oggPage
+
+	for len(b) > 0 {
+		require.GreaterOrEqual(t, len(b), 27)
+		require.Equal(t, "OggS", string(b[:4]))
+
+		nSegments := int(b[26])
+		lacing := b[27 : 27+nSegments]
+
+		size := 0
+		for _, l := range lacing {
+			size += int(l)
+		}
+
+		page := bytes.Clone(b[:27+nSegments+size])
+		crc := binary.LittleEndian.Uint32(page[22:])
+		binary.LittleEndian.PutUint32(page[22:], 0)
+		assert.Equal(t, oggCRC(0, page), crc)
+
+		pages = append(pages, oggPage{
+			flags:   b[5],
+			granule: int64(binary.LittleEndian.Uint64(b[6:])),
+			seq:     binary.LittleEndian.Uint32(b[18:]),
+			lacing:  lacing,
+			data:    b[27+nSegments : 27+nSegments+size],
+		})
+
+		b = b[27+nSegments+size:]
+	}
+
+	return pages
+}
+
+func TestOggCRC(t *testing.T) {
+	// CRC-32/CKSUM without the final XOR
+	assert.Equal(t, ^uint32(0x765e7680), oggCRC(0, []byte("123456789")))
+}
+
+func TestOggWriter_WritePage(t *testing.T) {
+	var buf bytes.Buffer
+
+	ow := &oggWriter{w: &buf, serial: 1}
+
+	assert.NoError(t, ow.writePage(make([]byte, 300), 960, oggFlagBOS))
+	assert.NoError(t, ow.writePage(make([]byte, 255), 1920, oggFlagEOS))
+
+	pages := readPages(t, buf.Bytes())
+	if assert.Len(t, pages, 2) {
+		assert.Equal(t, oggPage{flags: oggFlagBOS, granule: 960, seq: 0, lacing: []byte{255, 45}, data: make([]byte, 300)}, pages[0])
+		assert.Equal(t, []byte{255, 0}, pages[1].lacing)
+		assert.Equal(t, uint32(1), pages[1].seq)
+		assert.Equal(t, byte(oggFlagEOS), pages[1].flags)
+	}
+}
+
+func TestOggWriter_WritePage_TooLarge(t *testing.T) {
+	ow := &oggWriter{w: new(bytes.Buffer)}
+
+	assert.ErrorIs(t, ow.writePage(make([]byte, 255*255), 0, 0), ErrPacketTooLarge)
+}
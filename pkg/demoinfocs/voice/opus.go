@@ -0,0 +1,94 @@
+package voice
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Opus always uses a granule rate of 48 kHz in Ogg, regardless of the input sample rate.
+const opusGranuleRate = 48000
+
+// opusMaxPacketSamples is the maximum duration of an Opus packet (120 ms).
+const opusMaxPacketSamples = 5760
+
+// opusSilence is a 20 ms Opus packet (CELT, fullband, code 0) that decodes to silence.
+// It's used to fill the gaps between voice transmissions.
+var opusSilence = []byte{0xf8, 0xff, 0xfe}
+
+const opusSilenceSamples = 960
+
+// ErrInvalidOpusPacket is returned if the duration of an Opus packet can't be determined from its TOC byte.
+var ErrInvalidOpusPacket = errors.New("invalid Opus packet")
+
+// opusPacketSamples returns the duration of an Opus packet in samples at 48 kHz.
+// See RFC 6716, section 3.1.
+func opusPacketSamples(packet []byte) (int, error) {
+	if len(packet) == 0 {
+		return 0, ErrInvalidOpusPacket
+	}
+
+	config := packet[0] >> 3
+
+	var frameSamples int
+
+	switch {
+	case config < 12: // SILK: 10, 20, 40, 60 ms
+		frameSamples = [...]int{480, 960, 1920, 2880}[config%4]
+	case config < 16: // Hybrid: 10, 20 ms
+		frameSamples = [...]int{480, 960}[config%2]
+	default: // CELT: 2.5, 5, 10, 20 ms
+		frameSamples = [...]int{120, 240, 480, 960}[config%4]
+	}
+
+	var frames int
+
+	switch packet[0] & 0x3 {
+	case 0:
+		frames = 1
+	case 1, 2:
+		frames = 2
+	default:
+		if len(packet) < 2 {
+			return 0, ErrInvalidOpusPacket
+		}
+
+		frames = int(packet[1] & 0x3f)
+	}
+
+	samples := frames * frameSamples
+	if samples == 0 || samples > opusMaxPacketSamples {
+		return 0, ErrInvalidOpusPacket
+	}
+
+	return samples, nil
+}
+
+// opusHead returns the identification header of an Ogg Opus stream (RFC 7845, section 5.1).
+func opusHead(inputSampleRate uint32) []byte {
+	head := make([]byte, 19)
+	copy(head, "OpusHead")
+	head[8] = 1 // version
+	head[9] = 1 // channels
+	// pre-skip (bytes 10-11) is 0 since the packets don't start at the beginning of the encoder's stream
+	binary.LittleEndian.PutUint32(head[12:], inputSampleRate)
+	// output gain (bytes 16-17) and channel mapping family (byte 18) are 0
+
+	return head
+}
+
+// opusTags returns the comment header of an Ogg Opus stream (RFC 7845, section 5.2).
+func opusTags(comments ...string) []byte {
+	const vendor = "demoinfocs-golang"
+
+	tags := []byte("OpusTags")
+	tags = binary.LittleEndian.AppendUint32(tags, uint32(len(vendor)))
+	tags = append(tags, vendor...)
+	tags = binary.LittleEndian.AppendUint32(tags, uint32(len(comments)))
+
+	for _, c := range comments {
+		tags = binary.LittleEndian.AppendUint32(tags, uint32(len(c)))
+		tags = append(tags, c...)
+	}
+
+	return tags
+}
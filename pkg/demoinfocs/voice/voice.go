@@ -0,0 +1,288 @@
+// Package voice extracts the voice chat of CS2 demos into Ogg/Opus files.
+//
+// CS2 voice data (CSVCMsg_VoiceData) consists of Opus packets, which are muxed into Ogg containers without decoding them,
+// so no cgo or Opus library is needed. Gaps between transmissions are filled with silence,
+// so the timeline of each file matches the ticks of the demo, starting at the first transmission of the stream.
+//
+// Voice data is only included in demos recorded with tv_relayvoice 1.
+// Voice data of CS:GO demos (Steam / engine format) isn't supported.
+package voice
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	demoinfocs "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs"
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+)
+
+// defaultInputSampleRate is the sample rate of CS2 voice data if CMsgVoiceAudio doesn't contain it.
+const defaultInputSampleRate = 48000
+
+type packet struct {
+	offset int64 // Start of the packet in samples at 48 kHz since the stream's StartTick
+	data   []byte
+}
+
+// Stream contains the voice packets of a single speaker, either for the whole demo or a single round.
+type Stream struct {
+	SteamID64 uint64         // Xuid of the speaker, 0 if unknown
+	Client    int            // Client slot of the speaker
+	Player    *common.Player // May be nil if the speaker couldn't be found by SteamID64
+	Round     int            // Number of rounds played before the stream started, -1 if the Recorder doesn't split by round
+	StartTick int            // Ingame tick of the first packet
+	EndTick   int            // Ingame tick of the last packet
+
+	sampleRate uint32
+	packets    []packet
+	end        int64 // End of the last packet in samples at 48 kHz since StartTick
+}
+
+// Packets returns the number of Opus packets of the stream.
+func (s *Stream) Packets() int {
+	return len(s.packets)
+}
+
+// FileName returns the name of the stream's Ogg file, e.g. '76561198000000001_round03.ogg'.
+func (s *Stream) FileName() string {
+	name := "client" + strconv.Itoa(s.Client)
+	if s.SteamID64 != 0 {
+		name = strconv.FormatUint(s.SteamID64, 10)
+	}
+
+	if s.Round >= 0 {
+		name += fmt.Sprintf("_round%02d", s.Round)
+	}
+
+	return name + ".ogg"
+}
+
+// WriteOgg writes the stream as Ogg/Opus file.
+// Each packet is placed at the tick it was received at, gaps are filled with silence.
+// Streams without packets (e.g. if all packets were invalid) result in a valid file without audio.
+func (s *Stream) WriteOgg(w io.Writer) error {
+	ow := &oggWriter{w: w, serial: uint32(s.SteamID64) ^ uint32(s.Client)<<24 ^ uint32(s.Round)}
+
+	var comments []string
+	if s.Player != nil {
+		comments = append(comments, "ARTIST="+s.Player.Name)
+	}
+
+	sampleRate := s.sampleRate
+	if sampleRate == 0 {
+		sampleRate = defaultInputSampleRate
+	}
+
+	err := ow.writePage(opusHead(sampleRate), 0, oggFlagBOS)
+	if err != nil {
+		return fmt.Errorf("failed to write OpusHead: %w", err)
+	}
+
+	var tagsFlags byte
+	if len(s.packets) == 0 {
+		tagsFlags = oggFlagEOS
+	}
+
+	err = ow.writePage(opusTags(comments...), 0, tagsFlags)
+	if err != nil {
+		return fmt.Errorf("failed to write OpusTags: %w", err)
+	}
+
+	var granule int64
+
+	for i, p := range s.packets {
+		for p.offset-granule >= opusSilenceSamples {
+			granule += opusSilenceSamples
+
+			err = ow.writePage(opusSilence, granule, 0)
+			if err != nil {
+				return fmt.Errorf("failed to write silence: %w", err)
+			}
+		}
+
+		samples, _ := opusPacketSamples(p.data) // validated when the packet was added
+		granule += int64(samples)
+
+		var flags byte
+		if i == len(s.packets)-1 {
+			flags = oggFlagEOS
+		}
+
+		err = ow.writePage(p.data, granule, flags)
+		if err != nil {
+			return fmt.Errorf("failed to write packet %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// add adds Opus packets received at the given tick.
+// Packets whose duration can't be determined are skipped.
+func (s *Stream) add(tick int, tickRate float64, packets [][]byte) {
+	offset := s.end
+
+	if tickRate > 0 {
+		offset = max(offset, int64(float64(tick-s.StartTick)/tickRate*opusGranuleRate))
+	}
+
+	for _, data := range packets {
+		samples, err := opusPacketSamples(data)
+		if err != nil {
+			continue
+		}
+
+		s.packets = append(s.packets, packet{offset: offset, data: data})
+		offset += int64(samples)
+	}
+
+	s.end = offset
+	s.EndTick = tick
+}
+
+type streamKey struct {
+	steamID64 uint64
+	client    int
+	round     int
+}
+
+// Recorder collects the voice data of all speakers of a demo.
+type Recorder struct {
+	// SplitByRound creates a separate stream per speaker and round if true.
+	// Must be set before parsing starts.
+	SplitByRound bool
+
+	parser  demoinfocs.Parser
+	streams []*Stream
+	byKey   map[streamKey]*Stream
+}
+
+// NewRecorder creates a Recorder and registers its net-message handler on the parser.
+func NewRecorder(parser demoinfocs.Parser) *Recorder {
+	r := &Recorder{
+		parser: parser,
+		byKey:  make(map[streamKey]*Stream),
+	}
+
+	parser.RegisterNetMessageHandler(r.onVoiceData)
+
+	return r
+}
+
+// Streams returns all streams in the order they started.
+func (r *Recorder) Streams() []*Stream {
+	return r.streams
+}
+
+// WriteFiles writes all streams as Ogg/Opus files into dir (see Stream.FileName()) and returns the paths of the files.
+func (r *Recorder) WriteFiles(dir string) ([]string, error) {
+	paths := make([]string, 0, len(r.streams))
+
+	for _, s := range r.streams {
+		path := filepath.Join(dir, s.FileName())
+
+		err := writeFile(path, s)
+		if err != nil {
+			return paths, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func writeFile(path string, s *Stream) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %w", path, err)
+	}
+
+	err = s.WriteOgg(f)
+	if err != nil {
+		f.Close()
+
+		return fmt.Errorf("failed to write %q: %w", path, err)
+	}
+
+	return f.Close()
+}
+
+func (r *Recorder) onVoiceData(m *msg.CSVCMsg_VoiceData) {
+	audio := m.GetAudio()
+	if audio.GetFormat() != msg.VoiceDataFormatT_VOICEDATA_FORMAT_OPUS || len(audio.GetVoiceData()) == 0 {
+		return
+	}
+
+	gs := r.parser.GameState()
+
+	round := -1
+	if r.SplitByRound {
+		round = gs.TotalRoundsPlayed()
+	}
+
+	s := r.stream(m.GetXuid(), int(m.GetClient()), round, gs.IngameTick())
+	if s.sampleRate == 0 {
+		s.sampleRate = audio.GetSampleRate()
+	}
+
+	s.add(gs.IngameTick(), r.parser.TickRate(), splitPackets(audio))
+}
+
+// stream returns the stream of a speaker or creates a new one starting at tick.
+func (r *Recorder) stream(steamID64 uint64, client, round, tick int) *Stream {
+	key := streamKey{steamID64: steamID64, client: client, round: round}
+	if steamID64 != 0 {
+		key.client = 0 // the client slot may change if the player reconnects
+	}
+
+	s, ok := r.byKey[key]
+	if ok {
+		return s
+	}
+
+	s = &Stream{
+		SteamID64: steamID64,
+		Client:    client,
+		Round:     round,
+		StartTick: tick,
+	}
+
+	if steamID64 != 0 {
+		s.Player = r.parser.GameState().Participants().BySteamID64()[steamID64]
+	}
+
+	r.byKey[key] = s
+	r.streams = append(r.streams, s)
+
+	return s
+}
+
+// splitPackets splits the voice data into Opus packets.
+// If the message contains multiple packets, PacketOffsets contains the end offset of each packet.
+func splitPackets(audio *msg.CMsgVoiceAudio) [][]byte {
+	data := audio.GetVoiceData()
+	offsets := audio.GetPacketOffsets()
+
+	if audio.GetNumPackets() <= 1 || len(offsets) != int(audio.GetNumPackets()) || int(offsets[len(offsets)-1]) != len(data) {
+		return [][]byte{data}
+	}
+
+	packets := make([][]byte, 0, len(offsets))
+	start := uint32(0)
+
+	for _, end := range offsets {
+		if end <= start {
+			return [][]byte{data}
+		}
+
+		packets = append(packets, data[start:end])
+		start = end
+	}
+
+	return packets
+}
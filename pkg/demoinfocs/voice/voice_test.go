@@ -0,0 +1,183 @@
+package voice
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	common "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/common"
+	fake "github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/fake"
+	"github.com/markus-wa/demoinfocs-golang/v5/pkg/demoinfocs/msg"
+)
+
+// 20 ms CELT packets, the payload doesn't matter for muxing
+var (
+	opusPacketA = []byte{0xfc, 0x01, 0x02}
+	opusPacketB = []byte{0xfc, 0x03, 0x04}
+)
+
+func TestOpusPacketSamples(t *testing.T) {
+	tests := []struct {
+		packet  []byte
+		samples int
+	}{
+		{[]byte{0x08}, 960},             // SILK 20 ms
+		{[]byte{0x18 | 1}, 5760},        // SILK 60 ms, 2 frames
+		{[]byte{0x60}, 480},             // Hybrid 10 ms
+		{[]byte{0x80 | 3, 0x03}, 360},   // CELT 2.5 ms, 3 frames
+		{[]byte{0xf8, 0xff, 0xfe}, 960}, // silence
+	}
+
+	for _, test := range tests {
+		samples, err := opusPacketSamples(test.packet)
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.samples, samples)
+	}
+
+	for _, invalid := range [][]byte{nil, {0xfb}, {0xfb, 0x00}, {0x1b, 0x03}} {
+		_, err := opusPacketSamples(invalid)
+
+		assert.ErrorIs(t, err, ErrInvalidOpusPacket)
+	}
+}
+
+func TestStream_WriteOgg(t *testing.T) {
+	s := &Stream{StartTick: 100, Round: -1}
+
+	s.add(100, 64, [][]byte{opusPacketA, opusPacketB})
+	s.add(101, 64, [][]byte{opusPacketA}) // received before the previous packets ended
+	s.add(132, 64, [][]byte{opusPacketB}) // 0.5 s after the start, 0.44 s of silence
+	s.add(132, 64, [][]byte{{}})          // invalid packets are skipped
+
+	assert.Equal(t, 4, s.Packets())
+	assert.Equal(t, 132, s.EndTick)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, s.WriteOgg(&buf))
+
+	pages := readPages(t, buf.Bytes())
+	require.Len(t, pages, 2+3+22+1)
+
+	assert.Equal(t, opusHead(defaultInputSampleRate), pages[0].data)
+	assert.Equal(t, byte(oggFlagBOS), pages[0].flags)
+	assert.Equal(t, "OpusTags", string(pages[1].data[:8]))
+
+	assert.Equal(t, opusPacketA, pages[2].data)
+	assert.Equal(t, int64(960), pages[2].granule)
+	assert.Equal(t, opusPacketA, pages[4].data)
+	assert.Equal(t, int64(2880), pages[4].granule)
+
+	for _, silence := range pages[5:27] {
+		assert.Equal(t, opusSilence, silence.data)
+	}
+
+	last := pages[len(pages)-1]
+	assert.Equal(t, opusPacketB, last.data)
+	assert.Equal(t, int64(24000+960), last.granule)
+	assert.Equal(t, byte(oggFlagEOS), last.flags)
+}
+
+func TestStream_WriteOgg_NoPackets(t *testing.T) {
+	s := &Stream{StartTick: 100, Round: -1}
+
+	s.add(100, 64, [][]byte{{}})
+
+	assert.Zero(t, s.Packets())
+
+	var buf bytes.Buffer
+
+	require.NoError(t, s.WriteOgg(&buf))
+
+	pages := readPages(t, buf.Bytes())
+	require.Len(t, pages, 2)
+
+	assert.Equal(t, byte(oggFlagBOS), pages[0].flags)
+	assert.Equal(t, "OpusTags", string(pages[1].data[:8]))
+	assert.Equal(t, byte(oggFlagEOS), pages[1].flags)
+}
+
+func TestStream_FileName(t *testing.T) {
+	assert.Equal(t, "76561198000000001.ogg", (&Stream{SteamID64: 76561198000000001, Round: -1}).FileName())
+	assert.Equal(t, "client3_round05.ogg", (&Stream{Client: 3, Round: 5}).FileName())
+}
+
+func TestSplitPackets(t *testing.T) {
+	data := append(bytes.Clone(opusPacketA), opusPacketB...)
+
+	assert.Equal(t, [][]byte{opusPacketA, opusPacketB}, splitPackets(&msg.CMsgVoiceAudio{
+		VoiceData:     data,
+		NumPackets:    proto.Uint32(2),
+		PacketOffsets: []uint32{3, 6},
+	}))
+
+	// inconsistent offsets
+	assert.Equal(t, [][]byte{data}, splitPackets(&msg.CMsgVoiceAudio{
+		VoiceData:     data,
+		NumPackets:    proto.Uint32(2),
+		PacketOffsets: []uint32{3, 5},
+	}))
+	assert.Equal(t, [][]byte{data}, splitPackets(&msg.CMsgVoiceAudio{VoiceData: data}))
+}
+
+func TestRecorder(t *testing.T) {
+	pl := &common.Player{Name: "Speaker", SteamID64: 76561198000000001}
+
+	participants := new(fake.Participants)
+	participants.On("BySteamID64").Return(map[uint64]*common.Player{pl.SteamID64: pl})
+
+	gs := new(fake.GameState)
+	gs.On("IngameTick").Return(64)
+	gs.On("TotalRoundsPlayed").Return(2)
+	gs.On("Participants").Return(participants)
+
+	parser := fake.NewParser()
+	parser.On("GameState").Return(gs)
+	parser.On("TickRate").Return(64.0)
+
+	r := NewRecorder(parser)
+	r.SplitByRound = true
+
+	opus := msg.VoiceDataFormatT_VOICEDATA_FORMAT_OPUS
+	steam := msg.VoiceDataFormatT_VOICEDATA_FORMAT_STEAM
+
+	r.onVoiceData(&msg.CSVCMsg_VoiceData{
+		Client: proto.Int32(1),
+		Xuid:   proto.Uint64(pl.SteamID64),
+		Audio:  &msg.CMsgVoiceAudio{Format: &opus, VoiceData: opusPacketA, SampleRate: proto.Uint32(24000)},
+	})
+	r.onVoiceData(&msg.CSVCMsg_VoiceData{
+		Client: proto.Int32(4), // reconnected
+		Xuid:   proto.Uint64(pl.SteamID64),
+		Audio:  &msg.CMsgVoiceAudio{Format: &opus, VoiceData: opusPacketB},
+	})
+	r.onVoiceData(&msg.CSVCMsg_VoiceData{
+		Client: proto.Int32(2),
+		Audio:  &msg.CMsgVoiceAudio{Format: &steam, VoiceData: opusPacketA},
+	})
+
+	require.Len(t, r.Streams(), 1)
+
+	s := r.Streams()[0]
+	assert.Equal(t, pl, s.Player)
+	assert.Equal(t, 2, s.Round)
+	assert.Equal(t, 64, s.StartTick)
+	assert.Equal(t, 2, s.Packets())
+	assert.Equal(t, uint32(24000), s.sampleRate)
+
+	dir := t.TempDir()
+
+	paths, err := r.WriteFiles(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "76561198000000001_round02.ogg")}, paths)
+
+	b, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	assert.Len(t, readPages(t, b), 4)
+}